@@ -1,3 +1,5 @@
 package config
 
+// BaseURL is the default Paystack API base URL, used by clients created
+// without paystack.WithBaseURL.
 var BaseURL = "https://api.paystack.co"
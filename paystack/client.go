@@ -1,15 +1,96 @@
 package paystack
 
-import "net/http"
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aglili/gopaystack/config"
+)
 
 type Client struct {
-	secretKey  string
-	httpClient *http.Client
+	secretKey   string
+	baseURL     string
+	userAgent   string
+	timeout     time.Duration
+	retryPolicy RetryPolicy
+	httpClient  *http.Client
 }
 
-func NewClient(secretKey string) *Client {
-	return &Client{
+// Option configures a Client. Options are applied in order by NewClient.
+type Option func(*Client)
+
+// WithBaseURL overrides the API base URL used by the client, e.g. to point
+// it at a local stand-in server. Trailing slashes are ignored.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithHTTPClient sets the http.Client used to send requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithTimeout sets the overall timeout applied to every request.
+// The configured http.Client is copied, never modified in place.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// NewClient returns a Client authenticated with the given secret key.
+// Without options it talks to config.BaseURL using http.DefaultClient and
+// does not retry failed requests.
+func NewClient(secretKey string, opts ...Option) *Client {
+	c := &Client{
 		secretKey:  secretKey,
+		baseURL:    config.BaseURL,
 		httpClient: http.DefaultClient,
 	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.timeout > 0 {
+		httpClient := *c.httpClient
+		httpClient.Timeout = c.timeout
+		c.httpClient = &httpClient
+	}
+
+	return c
+}
+
+// newRequest builds a request against the client's base URL, bound to ctx so
+// that cancellation and deadlines reach the transport, and sets the
+// authorization, content type and user agent headers.
+func (c *Client) newRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	request, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Authorization", "Bearer "+c.secretKey)
+	request.Header.Set("Content-Type", "application/json")
+	if c.userAgent != "" {
+		request.Header.Set("User-Agent", c.userAgent)
+	}
+
+	return request, nil
 }
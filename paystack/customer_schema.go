@@ -1,5 +1,10 @@
 package paystack
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // CreateCustomerRequest represents the body parameters for the CreateCustomer API.
 type CreateCustomerRequest struct {
 	FirstName string                 `json:"first_name"`
@@ -9,69 +14,96 @@ type CreateCustomerRequest struct {
 	Metadata  map[string]interface{} `json:"metadata,omitempty"`
 }
 
-// CustomerResponse represents the response body for the CreateCustomer API.
-type CustomerResponse struct {
-	Status  bool   `json:"status"`
-	Message string `json:"message"`
-	Data    struct {
-		ID           int                    `json:"id"`
-		FirstName    string                 `json:"first_name"`
-		LastName     string                 `json:"last_name"`
-		Email        string                 `json:"email"`
-		Phone        string                 `json:"phone"`
-		CustomerCode string                 `json:"customer_code"`
-		Metadata     map[string]interface{} `json:"metadata"`
-	} `json:"data"`
+// Validate checks the request before it is sent.
+func (r *CreateCustomerRequest) Validate() error {
+	v := validation{}
+	v.email(r.Email, "email")
+
+	return v.err()
+}
+
+// Customer represents a customer object returned by the Paystack API.
+type Customer struct {
+	ID           int                    `json:"id"`
+	FirstName    string                 `json:"first_name"`
+	LastName     string                 `json:"last_name"`
+	Email        string                 `json:"email"`
+	Phone        string                 `json:"phone"`
+	CustomerCode string                 `json:"customer_code"`
+	RiskAction   string                 `json:"risk_action,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata"`
 }
 
+// CustomerResponse represents the response body for the CreateCustomer and UpdateCustomer APIs.
+type CustomerResponse = Response[Customer]
+
+// ListCustomersRequest represents the query parameters for the ListCustomers API.
 type ListCustomersRequest struct {
-	PerPage int    `json:"perPage,omitempty"`
-	Page    int    `json:"page,omitempty"`
-	From    string `json:"from,omitempty"`
-	To      string `json:"to,omitempty"`
+	PerPage int       `url:"perPage,omitempty"`
+	Page    int       `url:"page,omitempty"`
+	From    time.Time `url:"from,omitempty"`
+	To      time.Time `url:"to,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ListCustomersRequest) Validate() error {
+	v := validation{}
+	v.paging(r.PerPage, r.Page)
+	v.dateRange(r.From, r.To)
+
+	return v.err()
 }
 
-type ListCustomersResponse struct {
-	Status  bool   `json:"status"`
-	Message string `json:"message"`
-	Data    []struct {
-		ID           int                    `json:"id"`
-		FirstName    string                 `json:"first_name"`
-		LastName     string                 `json:"last_name"`
-		Email        string                 `json:"email"`
-		Phone        string                 `json:"phone"`
-		CustomerCode string                 `json:"customer_code"`
-		Metadata     map[string]interface{} `json:"metadata"`
-	} `json:"data"`
+// ListCustomersResponse represents the response body for the ListCustomers API.
+type ListCustomersResponse = Response[[]Customer]
+
+// CustomerDetails represents a customer together with the subscriptions and
+// transactions returned by the GetCustomer API.
+type CustomerDetails struct {
+	Customer
+	Subscriptions []CustomerSubscription `json:"subscriptions"`
+	Transaction   []CustomerTransaction  `json:"transactions"`
+}
+
+// CustomerSubscription represents a subscription listed on a customer.
+type CustomerSubscription struct {
+	ID               int    `json:"id"`
+	Plan             string `json:"plan"`
+	SubscriptionCode string `json:"subscription_code"`
+}
+
+// CustomerTransaction represents a transaction listed on a customer.
+type CustomerTransaction struct {
+	ID        int      `json:"id"`
+	Amount    Money    `json:"amount"`
+	Currency  Currency `json:"currency"`
+	Reference string   `json:"reference"`
 }
 
-type GetCustomerResponse struct {
-	Status  bool   `json:"status"`
-	Message string `json:"message"`
-	Data    struct {
-		ID            int    `json:"id"`
-		FirstName     string `json:"first_name"`
-		LastName      string `json:"last_name"`
-		Email         string `json:"email"`
-		Phone         string `json:"phone"`
-		CustomerCode  string `json:"customer_code"`
-		Subscriptions []struct {
-			ID               int    `json:"id"`
-			Plan             string `json:"plan"`
-			SubscriptionCode string `json:"subscription_code"`
-		} `json:"subscriptions"`
-		Transaction []struct {
-			ID        int    `json:"id"`
-			Amount    int    `json:"amount"`
-			Reference string `json:"reference"`
-		} `json:"transactions"`
-	} `json:"data"`
+// UnmarshalJSON decodes a customer transaction and sets the currency of its amount.
+func (t *CustomerTransaction) UnmarshalJSON(data []byte) error {
+	type customerTransaction CustomerTransaction
+	if err := json.Unmarshal(data, (*customerTransaction)(t)); err != nil {
+		return err
+	}
+
+	t.Amount.Currency = t.Currency
+	return nil
 }
 
+// GetCustomerResponse represents the response body for the GetCustomer API.
+type GetCustomerResponse = Response[CustomerDetails]
 
+// UpdateCustomerRequest represents the body parameters for the UpdateCustomer API.
 type UpdateCustomerRequest struct {
 	FirstName string                 `json:"first_name"`
 	LastName  string                 `json:"last_name"`
 	Phone     string                 `json:"phone,omitempty"`
 	Metadata  map[string]interface{} `json:"metadata,omitempty"`
 }
+
+// Validate checks the request before it is sent. Every field is optional,
+// so an update request is always valid.
+func (r *UpdateCustomerRequest) Validate() error {
+	return nil
+}
@@ -0,0 +1,145 @@
+package paystack
+
+import (
+	"context"
+)
+
+// CreateDedicatedAccount creates a dedicated virtual account for an existing customer.
+// It sends a POST request to the /dedicated_account endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a CreateDedicatedAccountRequest struct containing the customer code.
+//
+// Returns:
+//   - A pointer to a DedicatedAccountResponse struct containing the created account.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) CreateDedicatedAccount(ctx context.Context, req *CreateDedicatedAccountRequest) (*DedicatedAccountResponse, error) {
+	return call[DedicatedAccount](ctx, c, "POST", "/dedicated_account", req)
+}
+
+// AssignDedicatedAccount creates a customer, validates them and assigns them
+// a dedicated virtual account. The account is assigned asynchronously and
+// reported through the dedicatedaccount.assign webhook events.
+// It sends a POST request to the /dedicated_account/assign endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to an AssignDedicatedAccountRequest struct containing the customer details.
+//
+// Returns:
+//   - A pointer to a MessageResponse struct acknowledging the request.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) AssignDedicatedAccount(ctx context.Context, req *AssignDedicatedAccountRequest) (*MessageResponse, error) {
+	return call[interface{}](ctx, c, "POST", "/dedicated_account/assign", req)
+}
+
+// ListDedicatedAccounts retrieves the dedicated accounts available on the integration.
+// It sends a GET request to the /dedicated_account endpoint with the request encoded as query parameters.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a ListDedicatedAccountsRequest struct containing the request parameters, or nil.
+//
+// Returns:
+//   - A pointer to a ListDedicatedAccountsResponse struct containing the accounts.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ListDedicatedAccounts(ctx context.Context, req *ListDedicatedAccountsRequest) (*ListDedicatedAccountsResponse, error) {
+	path, err := withQuery("/dedicated_account", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return call[[]DedicatedAccount](ctx, c, "GET", path, nil)
+}
+
+// FetchDedicatedAccount retrieves a dedicated account by its ID.
+// It sends a GET request to the /dedicated_account/:id endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - id: The ID of the dedicated account.
+//
+// Returns:
+//   - A pointer to a DedicatedAccountResponse struct containing the account.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) FetchDedicatedAccount(ctx context.Context, id string) (*DedicatedAccountResponse, error) {
+	return call[DedicatedAccount](ctx, c, "GET", pathf("/dedicated_account/%s", id), nil)
+}
+
+// RequeryDedicatedAccount asks Paystack to check a dedicated account for
+// transfers it has not reported yet.
+// It sends a GET request to the /dedicated_account/requery endpoint with the request encoded as query parameters.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a RequeryDedicatedAccountRequest struct containing the account number and provider.
+//
+// Returns:
+//   - A pointer to a MessageResponse struct acknowledging the requery.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) RequeryDedicatedAccount(ctx context.Context, req *RequeryDedicatedAccountRequest) (*MessageResponse, error) {
+	path, err := withQuery("/dedicated_account/requery", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return call[interface{}](ctx, c, "GET", path, nil)
+}
+
+// DeactivateDedicatedAccount deactivates a dedicated account.
+// It sends a DELETE request to the /dedicated_account/:id endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - id: The ID of the dedicated account.
+//
+// Returns:
+//   - A pointer to a DedicatedAccountResponse struct containing the deactivated account.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) DeactivateDedicatedAccount(ctx context.Context, id string) (*DedicatedAccountResponse, error) {
+	return call[DedicatedAccount](ctx, c, "DELETE", pathf("/dedicated_account/%s", id), nil)
+}
+
+// SplitDedicatedAccountTransaction splits the payments into a customer's
+// dedicated account, creating the account if the customer has none.
+// It sends a POST request to the /dedicated_account/split endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a SplitDedicatedAccountTransactionRequest struct containing the split.
+//
+// Returns:
+//   - A pointer to a DedicatedAccountResponse struct containing the account.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) SplitDedicatedAccountTransaction(ctx context.Context, req *SplitDedicatedAccountTransactionRequest) (*DedicatedAccountResponse, error) {
+	return call[DedicatedAccount](ctx, c, "POST", "/dedicated_account/split", req)
+}
+
+// RemoveSplit removes the split from the payments into a dedicated account.
+// It sends a DELETE request to the /dedicated_account/split endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - accountNumber: The account number of the dedicated account.
+//
+// Returns:
+//   - A pointer to a DedicatedAccountResponse struct containing the account.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) RemoveSplit(ctx context.Context, accountNumber string) (*DedicatedAccountResponse, error) {
+	body := &removeSplitRequest{AccountNumber: accountNumber}
+	return call[DedicatedAccount](ctx, c, "DELETE", "/dedicated_account/split", body)
+}
+
+// FetchBankProviders retrieves the banks that can provide dedicated accounts.
+// It sends a GET request to the /dedicated_account/available_providers endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//
+// Returns:
+//   - A pointer to a BankProvidersResponse struct containing the providers.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) FetchBankProviders(ctx context.Context) (*BankProvidersResponse, error) {
+	return call[[]BankProvider](ctx, c, "GET", "/dedicated_account/available_providers", nil)
+}
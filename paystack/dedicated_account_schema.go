@@ -0,0 +1,176 @@
+package paystack
+
+// DedicatedAccount represents a virtual bank account assigned to a customer
+// for receiving bank transfers.
+type DedicatedAccount struct {
+	ID            int                         `json:"id"`
+	AccountName   string                      `json:"account_name"`
+	AccountNumber string                      `json:"account_number"`
+	Assigned      bool                        `json:"assigned"`
+	Active        bool                        `json:"active"`
+	Currency      Currency                    `json:"currency"`
+	Bank          DedicatedAccountBank        `json:"bank"`
+	Customer      Customer                    `json:"customer"`
+	Assignment    *DedicatedAccountAssignment `json:"assignment"`
+	// SplitConfig is the split applied to payments into the account, as returned by the API.
+	SplitConfig interface{} `json:"split_config"`
+	CreatedAt   string      `json:"created_at"`
+	UpdatedAt   string      `json:"updated_at"`
+}
+
+// DedicatedAccountBank represents the bank that provides a dedicated account.
+type DedicatedAccountBank struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// DedicatedAccountAssignment represents the assignment of a dedicated account to a customer.
+type DedicatedAccountAssignment struct {
+	Integration  int    `json:"integration"`
+	AssigneeID   int    `json:"assignee_id"`
+	AssigneeType string `json:"assignee_type"`
+	Expired      bool   `json:"expired"`
+	AccountType  string `json:"account_type"`
+	AssignedAt   string `json:"assigned_at"`
+}
+
+// CreateDedicatedAccountRequest represents the body parameters for the CreateDedicatedAccount API.
+type CreateDedicatedAccountRequest struct {
+	// Customer is the code of a customer created with CreateCustomer.
+	Customer string `json:"customer"`
+	// PreferredBank is the slug of a provider returned by FetchBankProviders.
+	PreferredBank string `json:"preferred_bank,omitempty"`
+	Subaccount    string `json:"subaccount,omitempty"`
+	SplitCode     string `json:"split_code,omitempty"`
+	FirstName     string `json:"first_name,omitempty"`
+	LastName      string `json:"last_name,omitempty"`
+	Phone         string `json:"phone,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *CreateDedicatedAccountRequest) Validate() error {
+	v := validation{}
+	v.required(r.Customer, "customer")
+
+	return v.err()
+}
+
+// DedicatedAccountResponse represents the response body for the CreateDedicatedAccount,
+// FetchDedicatedAccount, DeactivateDedicatedAccount, SplitDedicatedAccountTransaction
+// and RemoveSplit APIs.
+type DedicatedAccountResponse = Response[DedicatedAccount]
+
+// AssignDedicatedAccountRequest represents the body parameters for the AssignDedicatedAccount API,
+// which creates the customer, validates them and assigns them an account in one step.
+type AssignDedicatedAccountRequest struct {
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Phone     string `json:"phone"`
+	// PreferredBank is the slug of a provider returned by FetchBankProviders.
+	PreferredBank string `json:"preferred_bank"`
+	// Country is the two-letter country code of the customer, e.g. NG.
+	Country string `json:"country"`
+	// AccountNumber, BVN and BankCode identify the customer's own bank
+	// account for validation.
+	AccountNumber string `json:"account_number,omitempty"`
+	BVN           string `json:"bvn,omitempty"`
+	BankCode      string `json:"bank_code,omitempty"`
+	Subaccount    string `json:"subaccount,omitempty"`
+	SplitCode     string `json:"split_code,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *AssignDedicatedAccountRequest) Validate() error {
+	v := validation{}
+	v.email(r.Email, "email")
+	v.required(r.FirstName, "first_name")
+	v.required(r.LastName, "last_name")
+	v.required(r.Phone, "phone")
+	v.required(r.PreferredBank, "preferred_bank")
+	v.required(r.Country, "country")
+
+	return v.err()
+}
+
+// ListDedicatedAccountsRequest represents the query parameters for the ListDedicatedAccounts API.
+type ListDedicatedAccountsRequest struct {
+	Active       *bool    `url:"active,omitempty"`
+	Currency     Currency `url:"currency,omitempty"`
+	ProviderSlug string   `url:"provider_slug,omitempty"`
+	BankID       string   `url:"bank_id,omitempty"`
+	// Customer filters by customer ID.
+	Customer string `url:"customer,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ListDedicatedAccountsRequest) Validate() error {
+	v := validation{}
+	v.currency(r.Currency, "currency")
+
+	return v.err()
+}
+
+// ListDedicatedAccountsResponse represents the response body for the ListDedicatedAccounts API.
+type ListDedicatedAccountsResponse = Response[[]DedicatedAccount]
+
+// RequeryDedicatedAccountRequest represents the query parameters for the RequeryDedicatedAccount API.
+type RequeryDedicatedAccountRequest struct {
+	AccountNumber string `url:"account_number"`
+	ProviderSlug  string `url:"provider_slug"`
+	// Date is the day of the transfer, formatted as YYYY-MM-DD.
+	Date string `url:"date,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *RequeryDedicatedAccountRequest) Validate() error {
+	v := validation{}
+	v.required(r.AccountNumber, "account_number")
+	v.required(r.ProviderSlug, "provider_slug")
+
+	return v.err()
+}
+
+// SplitDedicatedAccountTransactionRequest represents the body parameters for the
+// SplitDedicatedAccountTransaction API.
+type SplitDedicatedAccountTransactionRequest struct {
+	// Customer is the code of a customer created with CreateCustomer.
+	Customer      string `json:"customer"`
+	Subaccount    string `json:"subaccount,omitempty"`
+	SplitCode     string `json:"split_code,omitempty"`
+	PreferredBank string `json:"preferred_bank,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *SplitDedicatedAccountTransactionRequest) Validate() error {
+	v := validation{}
+	v.required(r.Customer, "customer")
+	v.check(r.Subaccount != "" || r.SplitCode != "", "split_code", "is required when subaccount is not set")
+
+	return v.err()
+}
+
+// removeSplitRequest represents the body parameters for the RemoveSplit API.
+type removeSplitRequest struct {
+	AccountNumber string `json:"account_number"`
+}
+
+// Validate checks the request before it is sent.
+func (r *removeSplitRequest) Validate() error {
+	v := validation{}
+	v.required(r.AccountNumber, "account_number")
+
+	return v.err()
+}
+
+// BankProvider represents a bank that can provide dedicated accounts.
+type BankProvider struct {
+	ID           int    `json:"id"`
+	ProviderSlug string `json:"provider_slug"`
+	BankID       int    `json:"bank_id"`
+	BankName     string `json:"bank_name"`
+}
+
+// BankProvidersResponse represents the response body for the FetchBankProviders API.
+type BankProvidersResponse = Response[[]BankProvider]
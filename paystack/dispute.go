@@ -0,0 +1,211 @@
+package paystack
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// ListDisputes retrieves the disputes raised against the integration.
+// It sends a GET request to the /dispute endpoint with the request encoded as query parameters.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a ListDisputesRequest struct containing the request parameters, or nil.
+//
+// Returns:
+//   - A pointer to a ListDisputesResponse struct containing the disputes.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ListDisputes(ctx context.Context, req *ListDisputesRequest) (*ListDisputesResponse, error) {
+	path, err := withQuery("/dispute", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return call[[]Dispute](ctx, c, "GET", path, nil)
+}
+
+// IterateDisputes returns an iterator over every dispute matching req,
+// walking the pages of ListDisputes lazily. Iteration starts at req.Page
+// (or the first page) and stops early if ctx is cancelled.
+func (c *Client) IterateDisputes(ctx context.Context, req *ListDisputesRequest) *Iter[Dispute] {
+	params := ListDisputesRequest{}
+	if req != nil {
+		params = *req
+	}
+
+	return newIter(ctx, params.Page, func(ctx context.Context, page int) ([]Dispute, *Meta, error) {
+		params.Page = page
+		response, err := c.ListDisputes(ctx, &params)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, response.Meta, nil
+	})
+}
+
+// FetchDispute retrieves a dispute by its ID.
+// It sends a GET request to the /dispute/:id endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - id: The ID of the dispute.
+//
+// Returns:
+//   - A pointer to a DisputeResponse struct containing the dispute.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) FetchDispute(ctx context.Context, id string) (*DisputeResponse, error) {
+	return call[Dispute](ctx, c, "GET", pathf("/dispute/%s", id), nil)
+}
+
+// ListTransactionDisputes retrieves the dispute raised against a transaction.
+// It sends a GET request to the /dispute/transaction/:id endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - transactionID: The ID of the transaction.
+//
+// Returns:
+//   - A pointer to a DisputeResponse struct containing the dispute.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ListTransactionDisputes(ctx context.Context, transactionID string) (*DisputeResponse, error) {
+	return call[Dispute](ctx, c, "GET", pathf("/dispute/transaction/%s", transactionID), nil)
+}
+
+// UpdateDispute updates the refund amount or attachment of a dispute.
+// It sends a PUT request to the /dispute/:id endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - id: The ID of the dispute.
+//   - req: A pointer to an UpdateDisputeRequest struct containing the fields to update.
+//
+// Returns:
+//   - A pointer to a DisputeResponse struct containing the updated dispute.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) UpdateDispute(ctx context.Context, id string, req *UpdateDisputeRequest) (*DisputeResponse, error) {
+	return call[Dispute](ctx, c, "PUT", pathf("/dispute/%s", id), req)
+}
+
+// AddDisputeEvidence provides evidence to contest a dispute.
+// It sends a POST request to the /dispute/:id/evidence endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - id: The ID of the dispute.
+//   - req: A pointer to an AddDisputeEvidenceRequest struct containing the evidence.
+//
+// Returns:
+//   - A pointer to a DisputeEvidenceResponse struct containing the created evidence.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) AddDisputeEvidence(ctx context.Context, id string, req *AddDisputeEvidenceRequest) (*DisputeEvidenceResponse, error) {
+	return call[DisputeEvidence](ctx, c, "POST", pathf("/dispute/%s/evidence", id), req)
+}
+
+// GetDisputeUploadURL retrieves a pre-signed URL to upload an evidence file
+// to, see UploadDisputeEvidence.
+// It sends a GET request to the /dispute/:id/upload_url endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - id: The ID of the dispute.
+//   - fileName: The name of the file to upload, including its extension.
+//
+// Returns:
+//   - A pointer to a DisputeUploadURLResponse struct containing the URL and file name.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) GetDisputeUploadURL(ctx context.Context, id, fileName string) (*DisputeUploadURLResponse, error) {
+	path := pathf("/dispute/%s/upload_url", id) + "?" + url.Values{"upload_filename": {fileName}}.Encode()
+	return call[DisputeUploadURL](ctx, c, "GET", path, nil)
+}
+
+// UploadDisputeEvidence uploads an evidence file to the pre-signed URL
+// returned by GetDisputeUploadURL. The upload goes straight to the storage
+// provider, so it is neither authenticated with the secret key nor retried.
+// Pass upload.FileName as the uploaded_filename of UpdateDispute or
+// ResolveDispute afterwards.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - upload: A pointer to the DisputeUploadURL returned by GetDisputeUploadURL.
+//   - file: The contents of the file.
+//
+// Returns:
+//   - An error if upload has no signed URL, the file cannot be read or the upload fails.
+func (c *Client) UploadDisputeEvidence(ctx context.Context, upload *DisputeUploadURL, file io.Reader) error {
+	if upload == nil || upload.SignedURL == "" {
+		return errors.New("error uploading evidence: missing signed URL")
+	}
+
+	// the storage provider needs the content length, which a plain
+	// io.Reader cannot provide
+	contents, err := io.ReadAll(file)
+	if err != nil {
+		return fmt.Errorf("error reading evidence file: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, "PUT", upload.SignedURL, bytes.NewReader(contents))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	if c.userAgent != "" {
+		request.Header.Set("User-Agent", c.userAgent)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response body: %w", err)
+	}
+
+	// the storage provider is not Paystack, so its failures are not APIErrors
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("error uploading evidence: status %d: %s", response.StatusCode, body)
+	}
+
+	return nil
+}
+
+// ResolveDispute resolves a dispute by accepting or declining it.
+// It sends a PUT request to the /dispute/:id/resolve endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - id: The ID of the dispute.
+//   - req: A pointer to a ResolveDisputeRequest struct containing the resolution.
+//
+// Returns:
+//   - A pointer to a DisputeResponse struct containing the resolved dispute.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ResolveDispute(ctx context.Context, id string, req *ResolveDisputeRequest) (*DisputeResponse, error) {
+	return call[Dispute](ctx, c, "PUT", pathf("/dispute/%s/resolve", id), req)
+}
+
+// ExportDisputes requests a CSV export of the disputes matching req.
+// It sends a GET request to the /dispute/export endpoint with the request encoded as query parameters.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to an ExportDisputesRequest struct containing the request parameters, or nil.
+//
+// Returns:
+//   - A pointer to an ExportDisputesResponse struct containing the download path of the export.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ExportDisputes(ctx context.Context, req *ExportDisputesRequest) (*ExportDisputesResponse, error) {
+	path, err := withQuery("/dispute/export", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return call[TransactionExport](ctx, c, "GET", path, nil)
+}
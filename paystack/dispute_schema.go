@@ -0,0 +1,238 @@
+package paystack
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DisputeStatus is the state of a dispute.
+type DisputeStatus string
+
+// Dispute statuses returned by Paystack.
+const (
+	DisputeStatusAwaitingMerchantFeedback DisputeStatus = "awaiting-merchant-feedback"
+	DisputeStatusAwaitingBankFeedback     DisputeStatus = "awaiting-bank-feedback"
+	DisputeStatusPending                  DisputeStatus = "pending"
+	DisputeStatusResolved                 DisputeStatus = "resolved"
+	DisputeStatusArchived                 DisputeStatus = "archived"
+)
+
+// DisputeResolution is how a dispute was settled.
+type DisputeResolution string
+
+// Dispute resolutions supported by Paystack.
+const (
+	// DisputeResolutionMerchantAccepted accepts the chargeback and refunds the customer.
+	DisputeResolutionMerchantAccepted DisputeResolution = "merchant-accepted"
+	// DisputeResolutionDeclined contests the chargeback with evidence.
+	DisputeResolutionDeclined DisputeResolution = "declined"
+)
+
+// Dispute represents a chargeback or complaint raised against a transaction.
+type Dispute struct {
+	ID          int    `json:"id"`
+	Integration int    `json:"integration"`
+	Domain      string `json:"domain"`
+	// RefundAmount is in minor units of Currency.
+	RefundAmount         Money             `json:"refund_amount"`
+	Currency             Currency          `json:"currency"`
+	Status               DisputeStatus     `json:"status"`
+	Resolution           DisputeResolution `json:"resolution"`
+	Category             string            `json:"category"`
+	Source               string            `json:"source"`
+	Note                 string            `json:"note"`
+	Bin                  string            `json:"bin"`
+	Last4                string            `json:"last4"`
+	TransactionReference string            `json:"transaction_reference"`
+	Transaction          Transaction       `json:"transaction"`
+	Customer             Customer          `json:"customer"`
+	Evidence             *DisputeEvidence  `json:"evidence"`
+	Attachments          string            `json:"attachments"`
+	History              []DisputeHistory  `json:"history"`
+	Messages             []DisputeMessage  `json:"messages"`
+	DueAt                string            `json:"dueAt"`
+	ResolvedAt           string            `json:"resolvedAt"`
+	CreatedAt            string            `json:"createdAt"`
+	UpdatedAt            string            `json:"updatedAt"`
+}
+
+// UnmarshalJSON decodes a dispute and sets the currency of its refund amount.
+func (d *Dispute) UnmarshalJSON(data []byte) error {
+	type dispute Dispute
+	if err := json.Unmarshal(data, (*dispute)(d)); err != nil {
+		return err
+	}
+
+	d.RefundAmount.Currency = d.Currency
+	return nil
+}
+
+// IsResolved reports whether the dispute has been settled.
+func (d *Dispute) IsResolved() bool {
+	return d.Status == DisputeStatusResolved
+}
+
+// DisputeHistory represents a status change of a dispute.
+type DisputeHistory struct {
+	Status    DisputeStatus `json:"status"`
+	By        string        `json:"by"`
+	CreatedAt string        `json:"createdAt"`
+}
+
+// DisputeMessage represents a message exchanged on a dispute.
+type DisputeMessage struct {
+	Sender    string `json:"sender"`
+	Body      string `json:"body"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// DisputeEvidence represents the evidence provided to contest a dispute.
+type DisputeEvidence struct {
+	ID              int    `json:"id"`
+	Dispute         int    `json:"dispute"`
+	CustomerEmail   string `json:"customer_email"`
+	CustomerName    string `json:"customer_name"`
+	CustomerPhone   string `json:"customer_phone"`
+	ServiceDetails  string `json:"service_details"`
+	DeliveryAddress string `json:"delivery_address"`
+	DeliveryDate    string `json:"delivery_date"`
+	CreatedAt       string `json:"createdAt"`
+	UpdatedAt       string `json:"updatedAt"`
+}
+
+// DisputeResponse represents the response body for the FetchDispute, ListTransactionDisputes,
+// UpdateDispute and ResolveDispute APIs.
+type DisputeResponse = Response[Dispute]
+
+// ListDisputesRequest represents the query parameters for the ListDisputes API.
+type ListDisputesRequest struct {
+	PerPage int       `url:"perPage,omitempty"`
+	Page    int       `url:"page,omitempty"`
+	From    time.Time `url:"from,omitempty"`
+	To      time.Time `url:"to,omitempty"`
+	// Transaction filters by the ID of the disputed transaction.
+	Transaction string        `url:"transaction,omitempty"`
+	Status      DisputeStatus `url:"status,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ListDisputesRequest) Validate() error {
+	v := validation{}
+	v.paging(r.PerPage, r.Page)
+	v.dateRange(r.From, r.To)
+
+	return v.err()
+}
+
+// ListDisputesResponse represents the response body for the ListDisputes API.
+type ListDisputesResponse = Response[[]Dispute]
+
+// UpdateDisputeRequest represents the body parameters for the UpdateDispute API.
+type UpdateDisputeRequest struct {
+	// RefundAmount is the amount to refund; it is left unchanged when nil.
+	RefundAmount *Money `json:"refund_amount,omitempty"`
+	// UploadedFilename is the file name returned by GetDisputeUploadURL.
+	UploadedFilename string `json:"uploaded_filename,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *UpdateDisputeRequest) Validate() error {
+	v := validation{}
+	if r.RefundAmount != nil {
+		v.check(r.RefundAmount.Amount >= 0, "refund_amount", "must not be negative")
+		v.currency(r.RefundAmount.Currency, "refund_amount")
+	}
+
+	return v.err()
+}
+
+// AddDisputeEvidenceRequest represents the body parameters for the AddDisputeEvidence API.
+type AddDisputeEvidenceRequest struct {
+	CustomerEmail   string `json:"customer_email"`
+	CustomerName    string `json:"customer_name"`
+	CustomerPhone   string `json:"customer_phone"`
+	ServiceDetails  string `json:"service_details"`
+	DeliveryAddress string `json:"delivery_address,omitempty"`
+	// DeliveryDate is formatted as YYYY-MM-DD.
+	DeliveryDate string `json:"delivery_date,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *AddDisputeEvidenceRequest) Validate() error {
+	v := validation{}
+	v.email(r.CustomerEmail, "customer_email")
+	v.required(r.CustomerName, "customer_name")
+	v.required(r.CustomerPhone, "customer_phone")
+	v.required(r.ServiceDetails, "service_details")
+	if r.DeliveryDate != "" {
+		_, err := time.Parse("2006-01-02", r.DeliveryDate)
+		v.check(err == nil, "delivery_date", "must be formatted as YYYY-MM-DD")
+	}
+
+	return v.err()
+}
+
+// DisputeEvidenceResponse represents the response body for the AddDisputeEvidence API.
+type DisputeEvidenceResponse = Response[DisputeEvidence]
+
+// DisputeUploadURL represents a pre-signed URL that an evidence file can be uploaded to.
+type DisputeUploadURL struct {
+	SignedURL string `json:"signedUrl"`
+	// FileName is passed as the uploaded_filename of UpdateDispute and ResolveDispute.
+	FileName string `json:"fileName"`
+	// ExpiresIn is how long, in seconds, the URL remains valid.
+	ExpiresIn int `json:"expiresIn"`
+}
+
+// DisputeUploadURLResponse represents the response body for the GetDisputeUploadURL API.
+type DisputeUploadURLResponse = Response[DisputeUploadURL]
+
+// ResolveDisputeRequest represents the body parameters for the ResolveDispute API.
+type ResolveDisputeRequest struct {
+	Resolution DisputeResolution `json:"resolution"`
+	Message    string            `json:"message"`
+	// RefundAmount is the amount to refund; it is zero when declining.
+	RefundAmount Money `json:"refund_amount"`
+	// UploadedFilename is the file name returned by GetDisputeUploadURL.
+	UploadedFilename string `json:"uploaded_filename"`
+	// Evidence is the ID of the evidence added with AddDisputeEvidence; it
+	// is required for fraud claims.
+	Evidence int `json:"evidence,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ResolveDisputeRequest) Validate() error {
+	v := validation{}
+	v.check(r.Resolution == DisputeResolutionMerchantAccepted || r.Resolution == DisputeResolutionDeclined,
+		"resolution", fmt.Sprintf("unsupported resolution %q", r.Resolution))
+	v.required(r.Message, "message")
+	v.check(r.RefundAmount.Amount >= 0, "refund_amount", "must not be negative")
+	v.currency(r.RefundAmount.Currency, "refund_amount")
+	v.required(r.UploadedFilename, "uploaded_filename")
+
+	return v.err()
+}
+
+// ExportDisputesRequest represents the query parameters for the ExportDisputes API.
+type ExportDisputesRequest struct {
+	PerPage     int           `url:"perPage,omitempty"`
+	Page        int           `url:"page,omitempty"`
+	From        time.Time     `url:"from,omitempty"`
+	To          time.Time     `url:"to,omitempty"`
+	Transaction string        `url:"transaction,omitempty"`
+	Status      DisputeStatus `url:"status,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ExportDisputesRequest) Validate() error {
+	v := validation{}
+	v.paging(r.PerPage, r.Page)
+	v.dateRange(r.From, r.To)
+
+	return v.err()
+}
+
+// ExportDisputesResponse represents the response body for the ExportDisputes API.
+// The export has the same shape as a transaction export.
+type ExportDisputesResponse = Response[TransactionExport]
@@ -0,0 +1,81 @@
+package paystack
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// APIError is returned for any non-2xx response from the Paystack API.
+// Use errors.As to inspect it, or the IsNotFound, IsUnauthorized and
+// IsRateLimited helpers for the common cases.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"-"`
+	// Message is the human readable message returned by Paystack.
+	Message string `json:"message"`
+	// Type and Code are the error classification fields Paystack returns,
+	// e.g. "validation_error" and "invalid_params".
+	Type string `json:"type"`
+	Code string `json:"code"`
+	// Meta carries extra guidance from Paystack, such as "nextStep".
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	// RequestID identifies the request in Paystack's and Cloudflare's logs.
+	RequestID string `json:"-"`
+	// Body is the raw response body.
+	Body []byte `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("paystack: API error (status %d): %s", e.StatusCode, e.Body)
+	}
+	return fmt.Sprintf("paystack: API error (status %d): %s", e.StatusCode, e.Message)
+}
+
+// newAPIError builds an APIError from a failed response and its body.
+// The body is decoded on a best-effort basis; a body that is not JSON
+// still yields an error carrying the status code and raw body.
+func newAPIError(response *http.Response, body []byte) *APIError {
+	apiErr := &APIError{}
+	_ = json.Unmarshal(body, apiErr)
+
+	apiErr.StatusCode = response.StatusCode
+	apiErr.Body = body
+	apiErr.RequestID = response.Header.Get("X-Request-Id")
+	if apiErr.RequestID == "" {
+		apiErr.RequestID = response.Header.Get("CF-Ray")
+	}
+
+	return apiErr
+}
+
+// IsNotFound reports whether err is an APIError with status 404.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is an APIError with status 401,
+// which Paystack returns for a missing or invalid secret key.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsRateLimited reports whether err is an APIError with status 429.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsValidationError reports whether err is a *ValidationError, returned
+// when a request fails the client-side checks, or an APIError with status
+// 400, which Paystack returns when a request fails its validation.
+func IsValidationError(err error) bool {
+	var validationErr *ValidationError
+	return errors.As(err, &validationErr) || hasStatus(err, http.StatusBadRequest)
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
@@ -0,0 +1,82 @@
+package paystack
+
+import (
+	"context"
+)
+
+// Iter lazily walks every page of a list endpoint, fetching the next page
+// only once the items of the current one have been consumed.
+//
+//	it := client.IterateCustomers(ctx, &paystack.ListCustomersRequest{PerPage: 100})
+//	for it.Next() {
+//	    customer := it.Current()
+//	    ...
+//	}
+//	if err := it.Err(); err != nil {
+//	    ...
+//	}
+type Iter[T any] struct {
+	ctx     context.Context
+	fetch   func(ctx context.Context, page int) ([]T, *Meta, error)
+	page    int
+	items   []T
+	current T
+	meta    *Meta
+	done    bool
+	err     error
+}
+
+// newIter returns an Iter starting at the given page (1 when page < 1).
+func newIter[T any](ctx context.Context, page int, fetch func(ctx context.Context, page int) ([]T, *Meta, error)) *Iter[T] {
+	if page < 1 {
+		page = 1
+	}
+
+	return &Iter[T]{ctx: ctx, fetch: fetch, page: page}
+}
+
+// Next advances the iterator to the next item, fetching a new page when
+// needed. It returns false once every page has been consumed, the context
+// is done or a request fails; check Err to tell these apart.
+func (it *Iter[T]) Next() bool {
+	for len(it.items) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		items, meta, err := it.fetch(it.ctx, it.page)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.items = items
+		it.meta = meta
+		it.done = len(items) == 0 || (meta != nil && meta.PageCount > 0 && it.page >= meta.PageCount)
+		it.page++
+	}
+
+	it.current = it.items[0]
+	it.items = it.items[1:]
+
+	return true
+}
+
+// Current returns the item the iterator is positioned on.
+func (it *Iter[T]) Current() T {
+	return it.current
+}
+
+// Meta returns the pagination metadata of the most recently fetched page.
+func (it *Iter[T]) Meta() *Meta {
+	return it.meta
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *Iter[T]) Err() error {
+	return it.err
+}
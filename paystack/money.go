@@ -0,0 +1,206 @@
+package paystack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency code supported by Paystack.
+type Currency string
+
+// Currencies supported by Paystack.
+const (
+	CurrencyNGN Currency = "NGN"
+	CurrencyGHS Currency = "GHS"
+	CurrencyZAR Currency = "ZAR"
+	CurrencyKES Currency = "KES"
+	CurrencyUSD Currency = "USD"
+	CurrencyXOF Currency = "XOF"
+)
+
+// minorUnitsPerMajor is the number of minor units in one major unit.
+// Paystack expresses every supported currency in hundredths (kobo,
+// pesewas, cents), XOF included.
+const minorUnitsPerMajor = 100
+
+// IsSupported reports whether Paystack accepts payments in the currency.
+func (c Currency) IsSupported() bool {
+	switch c {
+	case CurrencyNGN, CurrencyGHS, CurrencyZAR, CurrencyKES, CurrencyUSD, CurrencyXOF:
+		return true
+	}
+
+	return false
+}
+
+// Money is an amount of money in the minor unit of its currency, e.g. kobo
+// for NGN. On the wire it is the bare integer Paystack expects in "amount"
+// fields; the currency travels in the neighbouring "currency" field.
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+// NewMoney returns Money for an amount already in minor units.
+func NewMoney(minor int64, currency Currency) Money {
+	return Money{Amount: minor, Currency: currency}
+}
+
+// MoneyFromMajor returns Money for an amount in major units, e.g. naira.
+// major must lie within ±math.MaxInt64/100 or the result overflows; use
+// ParseMoney for amounts that are not known to be in range.
+func MoneyFromMajor(major int64, currency Currency) Money {
+	return Money{Amount: major * minorUnitsPerMajor, Currency: currency}
+}
+
+// ParseMoney parses a decimal amount in major units, such as "1500" or
+// "1500.50", into Money. More than two decimal places is an error rather
+// than being silently rounded.
+func ParseMoney(s string, currency Currency) (Money, error) {
+	value := strings.TrimSpace(s)
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+
+	whole, fraction, hasFraction := strings.Cut(value, ".")
+	if whole == "" && fraction == "" || len(fraction) > 2 || hasFraction && fraction == "" ||
+		!isDigits(whole) || !isDigits(fraction) {
+		return Money{}, fmt.Errorf("invalid amount %q", s)
+	}
+	if whole == "" {
+		whole = "0"
+	}
+
+	major, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || major < 0 {
+		return Money{}, fmt.Errorf("invalid amount %q", s)
+	}
+
+	minor := int64(0)
+	if fraction != "" {
+		for len(fraction) < 2 {
+			fraction += "0"
+		}
+		minor, err = strconv.ParseInt(fraction, 10, 64)
+		if err != nil || minor < 0 {
+			return Money{}, fmt.Errorf("invalid amount %q", s)
+		}
+	}
+
+	if major > (math.MaxInt64-minor)/minorUnitsPerMajor {
+		return Money{}, fmt.Errorf("amount %q out of range", s)
+	}
+
+	amount := major*minorUnitsPerMajor + minor
+	if negative {
+		amount = -amount
+	}
+
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// isDigits reports whether s contains only ASCII digits. strconv.ParseInt
+// alone would also accept a leading sign.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Major returns the amount in major units as a decimal string, e.g. "1500.50".
+func (m Money) Major() string {
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, amount/minorUnitsPerMajor, amount%minorUnitsPerMajor)
+}
+
+// String formats the amount with its currency, e.g. "NGN 1500.50".
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Major()
+	}
+
+	return string(m.Currency) + " " + m.Major()
+}
+
+// IsZero reports whether the amount is zero.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Add returns m + o. Both must be in the same currency; Money without a
+// currency takes on the other's.
+func (m Money) Add(o Money) (Money, error) {
+	currency, err := m.sameCurrency(o)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: m.Amount + o.Amount, Currency: currency}, nil
+}
+
+// Sub returns m - o. Both must be in the same currency; Money without a
+// currency takes on the other's.
+func (m Money) Sub(o Money) (Money, error) {
+	currency, err := m.sameCurrency(o)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: m.Amount - o.Amount, Currency: currency}, nil
+}
+
+// Mul returns m multiplied by n.
+func (m Money) Mul(n int64) Money {
+	return Money{Amount: m.Amount * n, Currency: m.Currency}
+}
+
+func (m Money) sameCurrency(o Money) (Currency, error) {
+	switch {
+	case m.Currency == o.Currency || o.Currency == "":
+		return m.Currency, nil
+	case m.Currency == "":
+		return o.Currency, nil
+	}
+
+	return "", fmt.Errorf("currency mismatch: %s and %s", m.Currency, o.Currency)
+}
+
+// MarshalJSON encodes the amount as an integer number of minor units.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(m.Amount, 10)), nil
+}
+
+// UnmarshalJSON decodes an integer number of minor units, also accepting
+// it quoted as a string. The currency is left untouched.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(data, `"`)
+	if string(data) == "null" || len(data) == 0 {
+		return nil
+	}
+
+	var amount json.Number
+	if err := json.Unmarshal(data, &amount); err != nil {
+		return fmt.Errorf("invalid amount %s: %w", data, err)
+	}
+
+	value, err := amount.Int64()
+	if err != nil {
+		return fmt.Errorf("invalid amount %s: %w", data, err)
+	}
+
+	m.Amount = value
+	return nil
+}
@@ -1,85 +1,88 @@
 package paystack
 
 import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"io/ioutil"
-	"net/http"
-
-	"github.com/aglili/gopaystack/config"
+	"context"
 )
 
-func (c Client) CreatePlan(req *CreatePlanRequest) (*PlanResponse, error) {
-	url := config.BaseURL + "/plan"
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling request: %v", err)
-	}
-
-	request, err := http.NewRequest("POST", url, bytes.NewBuffer(payload))
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
-	}
-
-	request.Header.Set("Authorization", "Bearer "+c.secretKey)
-	request.Header.Set("Content-Type", "application/json")
-
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
-	}
-	defer response.Body.Close()
-
-	body, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response: %v", err)
-	}
-
-	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error creating plan: %s", body)
-	}
-
-	var planResponse PlanResponse
-	err = json.Unmarshal(body, &planResponse)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshalling response: %v", err)
-	}
-
-	return &planResponse, nil
+// CreatePlan creates a new subscription plan.
+// It sends a POST request to the /plan endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a CreatePlanRequest struct containing the plan details.
+//
+// Returns:
+//   - A pointer to a PlanResponse struct containing the created plan.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) CreatePlan(ctx context.Context, req *CreatePlanRequest) (*PlanResponse, error) {
+	return call[Plan](ctx, c, "POST", "/plan", req)
 }
 
-func (c Client) ListPlans() (*ListPlansResponse, error) {
-	url := config.BaseURL + "/plan"
-
-	request, err := http.NewRequest("GET", url, nil)
+// ListPlans retrieves the plans available on the integration.
+// It sends a GET request to the /plan endpoint with the request encoded as query parameters.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a ListPlansRequest struct containing the request parameters, or nil.
+//
+// Returns:
+//   - A pointer to a ListPlansResponse struct containing the plans.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ListPlans(ctx context.Context, req *ListPlansRequest) (*ListPlansResponse, error) {
+	path, err := withQuery("/plan", req)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+		return nil, err
 	}
 
-	request.Header.Set("Authorization", "Bearer "+c.secretKey)
+	return call[[]Plan](ctx, c, "GET", path, nil)
+}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
-	}
-	defer response.Body.Close()
+// FetchPlan retrieves a plan by its ID or code.
+// It sends a GET request to the /plan/:id_or_code endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - idOrCode: The ID or plan code of the plan.
+//
+// Returns:
+//   - A pointer to a PlanResponse struct containing the plan.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) FetchPlan(ctx context.Context, idOrCode string) (*PlanResponse, error) {
+	return call[Plan](ctx, c, "GET", pathf("/plan/%s", idOrCode), nil)
+}
 
-	body, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response: %v", err)
-	}
+// UpdatePlan updates the details of a plan.
+// It sends a PUT request to the /plan/:id_or_code endpoint with the provided request payload.
+// Paystack does not return the updated plan, only a message.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - idOrCode: The ID or plan code of the plan.
+//   - req: A pointer to an UpdatePlanRequest struct containing the fields to update.
+//
+// Returns:
+//   - A pointer to a MessageResponse struct containing the result message.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) UpdatePlan(ctx context.Context, idOrCode string, req *UpdatePlanRequest) (*MessageResponse, error) {
+	return call[interface{}](ctx, c, "PUT", pathf("/plan/%s", idOrCode), req)
+}
 
-	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error listing plans: %s", body)
+// IteratePlans returns an iterator over every plan matching req, walking
+// the pages of ListPlans lazily. Iteration starts at req.Page (or the first
+// page) and stops early if ctx is cancelled.
+func (c *Client) IteratePlans(ctx context.Context, req *ListPlansRequest) *Iter[Plan] {
+	params := ListPlansRequest{}
+	if req != nil {
+		params = *req
 	}
 
-	var listPlansResponse ListPlansResponse
-	err = json.Unmarshal(body, &listPlansResponse)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshalling response: %v", err)
-	}
+	return newIter(ctx, params.Page, func(ctx context.Context, page int) ([]Plan, *Meta, error) {
+		params.Page = page
+		response, err := c.ListPlans(ctx, &params)
+		if err != nil {
+			return nil, nil, err
+		}
 
-	return &listPlansResponse, nil
+		return response.Data, response.Meta, nil
+	})
 }
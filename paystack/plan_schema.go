@@ -1,31 +1,179 @@
 package paystack
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PlanInterval is how often a plan bills its subscribers.
+type PlanInterval string
+
+// Plan intervals supported by Paystack.
+const (
+	PlanIntervalHourly     PlanInterval = "hourly"
+	PlanIntervalDaily      PlanInterval = "daily"
+	PlanIntervalWeekly     PlanInterval = "weekly"
+	PlanIntervalMonthly    PlanInterval = "monthly"
+	PlanIntervalQuarterly  PlanInterval = "quarterly"
+	PlanIntervalBiannually PlanInterval = "biannually"
+	PlanIntervalAnnually   PlanInterval = "annually"
+)
+
+// IsValid reports whether the interval is one Paystack supports.
+func (i PlanInterval) IsValid() bool {
+	switch i {
+	case PlanIntervalHourly, PlanIntervalDaily, PlanIntervalWeekly, PlanIntervalMonthly,
+		PlanIntervalQuarterly, PlanIntervalBiannually, PlanIntervalAnnually:
+		return true
+	}
+
+	return false
+}
+
 // CreatePlanRequest represents the body parameters for the CreatePlan API.
 type CreatePlanRequest struct {
-	Name         string `json:"name"`
-	Amount       int    `json:"amount"`
-	Interval     string `json:"interval"`
-	Description  string `json:"description,omitempty"`
-	SendInvoices bool   `json:"send_invoices,omitempty"`
-	SendSMS      bool   `json:"send_sms,omitempty"`
-	Currency     string `json:"currency,omitempty"`
+	Name string `json:"name"`
+	// Amount is sent in minor units. Its currency is sent as Currency
+	// unless Currency is set explicitly.
+	Amount       Money        `json:"amount"`
+	Interval     PlanInterval `json:"interval"`
+	Description  string       `json:"description,omitempty"`
+	SendInvoices bool         `json:"send_invoices,omitempty"`
+	SendSMS      bool         `json:"send_sms,omitempty"`
+	Currency     Currency     `json:"currency,omitempty"`
+}
+
+// MarshalJSON fills in the currency from Amount when Currency is not set.
+func (r CreatePlanRequest) MarshalJSON() ([]byte, error) {
+	type request CreatePlanRequest
+	if r.Currency == "" {
+		r.Currency = r.Amount.Currency
+	}
+
+	return json.Marshal(request(r))
+}
+
+// Validate checks the request before it is sent.
+func (r *CreatePlanRequest) Validate() error {
+	v := validation{}
+	v.required(r.Name, "name")
+	v.positive(r.Amount.Amount, "amount")
+	v.check(r.Interval.IsValid(), "interval", fmt.Sprintf("unsupported interval %q", r.Interval))
+	v.currency(r.Currency, "currency")
+	v.currency(r.Amount.Currency, "amount")
+	v.check(r.Currency == "" || r.Amount.Currency == "" || r.Currency == r.Amount.Currency,
+		"currency", "does not match the currency of amount")
+
+	return v.err()
+}
+
+// PlanResponse represents the response body for the CreatePlan and FetchPlan APIs.
+type PlanResponse = Response[Plan]
+
+// Plan represents a subscription plan returned by the Paystack API.
+type Plan struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	PlanCode    string `json:"plan_code"`
+	Description string `json:"description"`
+	// Amount is in minor units of Currency.
+	Amount            Money        `json:"amount"`
+	Interval          PlanInterval `json:"interval"`
+	Currency          Currency     `json:"currency"`
+	SendInvoices      bool         `json:"send_invoices"`
+	SendSMS           bool         `json:"send_sms"`
+	HostedPage        bool         `json:"hosted_page"`
+	HostedPageURL     string       `json:"hosted_page_url"`
+	HostedPageSummary string       `json:"hosted_page_summary"`
+	// InvoiceLimit is the number of times subscribers are charged; 0 means
+	// until the subscription is cancelled.
+	InvoiceLimit int    `json:"invoice_limit"`
+	Integration  int    `json:"integration"`
+	Domain       string `json:"domain"`
+	IsDeleted    bool   `json:"is_deleted"`
+	IsArchived   bool   `json:"is_archived"`
+	// The subscription counts are only returned by the ListPlans API.
+	TotalSubscriptions        int    `json:"total_subscriptions"`
+	ActiveSubscriptions       int    `json:"active_subscriptions"`
+	TotalSubscriptionsRevenue int64  `json:"total_subscriptions_revenue"`
+	CreatedAt                 string `json:"createdAt"`
+	UpdatedAt                 string `json:"updatedAt"`
+}
+
+// UnmarshalJSON decodes a plan and sets the currency of its amount.
+func (p *Plan) UnmarshalJSON(data []byte) error {
+	type plan Plan
+	if err := json.Unmarshal(data, (*plan)(p)); err != nil {
+		return err
+	}
+
+	p.Amount.Currency = p.Currency
+	return nil
 }
 
-// PlanResponse represents the response from the CreatePlan API.
-type PlanResponse struct {
-	Status  bool              `json:"status"`
-	Message string            `json:"message"`
-	Data    CreatePlanRequest `json:"data"`
+// ListPlansRequest represents the query parameters for the ListPlans API.
+type ListPlansRequest struct {
+	PerPage  int          `url:"perPage,omitempty"`
+	Page     int          `url:"page,omitempty"`
+	Status   string       `url:"status,omitempty"`
+	Interval PlanInterval `url:"interval,omitempty"`
+	// Amount filters by plan amount in minor units.
+	Amount int `url:"amount,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ListPlansRequest) Validate() error {
+	v := validation{}
+	v.paging(r.PerPage, r.Page)
+	v.check(r.Interval == "" || r.Interval.IsValid(), "interval", fmt.Sprintf("unsupported interval %q", r.Interval))
+	v.check(r.Amount >= 0, "amount", "must not be negative")
+
+	return v.err()
 }
 
 // ListPlansResponse represents the response from the ListPlans API.
-type ListPlansResponse struct {
-	Status  bool   `json:"status"`
-	Message string `json:"message"`
-	Data    []struct {
-		Name        string `json:"name"`
-		Amount      int    `json:"amount"`
-		Description string `json:"description"`
-		PlanCode    string `json:"plan_code"`
-	} `json:"data"`
+type ListPlansResponse = Response[[]Plan]
+
+// UpdatePlanRequest represents the body parameters for the UpdatePlan API.
+// Only the fields that are set are updated.
+type UpdatePlanRequest struct {
+	Name         string       `json:"name,omitempty"`
+	Amount       *Money       `json:"amount,omitempty"`
+	Interval     PlanInterval `json:"interval,omitempty"`
+	Description  string       `json:"description,omitempty"`
+	SendInvoices *bool        `json:"send_invoices,omitempty"`
+	SendSMS      *bool        `json:"send_sms,omitempty"`
+	Currency     Currency     `json:"currency,omitempty"`
+	InvoiceLimit *int         `json:"invoice_limit,omitempty"`
+	// UpdateExistingSubscriptions controls whether the changes apply to
+	// existing subscriptions. Paystack applies them when it is nil.
+	UpdateExistingSubscriptions *bool `json:"update_existing_subscriptions,omitempty"`
+}
+
+// MarshalJSON fills in the currency from Amount when Currency is not set.
+func (r UpdatePlanRequest) MarshalJSON() ([]byte, error) {
+	type request UpdatePlanRequest
+	if r.Currency == "" && r.Amount != nil {
+		r.Currency = r.Amount.Currency
+	}
+
+	return json.Marshal(request(r))
+}
+
+// Validate checks the request before it is sent.
+func (r *UpdatePlanRequest) Validate() error {
+	v := validation{}
+	if r.Amount != nil {
+		v.positive(r.Amount.Amount, "amount")
+		v.currency(r.Amount.Currency, "amount")
+		v.check(r.Currency == "" || r.Amount.Currency == "" || r.Currency == r.Amount.Currency,
+			"currency", "does not match the currency of amount")
+	}
+	v.check(r.Interval == "" || r.Interval.IsValid(), "interval", fmt.Sprintf("unsupported interval %q", r.Interval))
+	v.currency(r.Currency, "currency")
+	if r.InvoiceLimit != nil {
+		v.check(*r.InvoiceLimit >= 0, "invoice_limit", "must not be negative")
+	}
+
+	return v.err()
 }
@@ -0,0 +1,102 @@
+package paystack
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// encodeQuery encodes the exported fields of the struct pointed to by v as
+// URL query parameters. Fields are named by their `url` tag; a tag of "-"
+// skips the field and the "omitempty" option skips zero values. Nil pointers
+// are skipped and non-nil pointers are always encoded. Strings, integers,
+// booleans, time.Time (formatted as RFC 3339) and pointers to those are
+// supported. A nil v encodes to an empty query.
+func encodeQuery(v interface{}) (url.Values, error) {
+	values := url.Values{}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return values, nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return values, nil
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot encode %s as query parameters", rv.Type())
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag := field.Tag.Get("url")
+		if !field.IsExported() || tag == "" || tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		omitempty := opts == "omitempty"
+
+		// Pointers let callers send zero values such as false that
+		// omitempty would otherwise drop.
+		fv := rv.Field(i)
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		} else if omitempty && fv.IsZero() {
+			continue
+		}
+
+		value, err := formatQueryValue(fv)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode field %s: %w", field.Name, err)
+		}
+		values.Set(name, value)
+	}
+
+	return values, nil
+}
+
+func formatQueryValue(v reflect.Value) (string, error) {
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.UTC().Format(time.RFC3339), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// withQuery validates params and appends their query encoding to path.
+func withQuery(path string, params interface{}) (string, error) {
+	if err := validate(params); err != nil {
+		return "", err
+	}
+
+	values, err := encodeQuery(params)
+	if err != nil {
+		return "", fmt.Errorf("error encoding query: %w", err)
+	}
+	if len(values) == 0 {
+		return path, nil
+	}
+
+	return path + "?" + values.Encode(), nil
+}
@@ -0,0 +1,116 @@
+package paystack
+
+import (
+	"context"
+)
+
+// CreateTransferRecipient creates a beneficiary that transfers can be sent to.
+// It sends a POST request to the /transferrecipient endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a CreateTransferRecipientRequest struct containing the recipient details.
+//
+// Returns:
+//   - A pointer to a TransferRecipientResponse struct containing the created recipient.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) CreateTransferRecipient(ctx context.Context, req *CreateTransferRecipientRequest) (*TransferRecipientResponse, error) {
+	return call[TransferRecipient](ctx, c, "POST", "/transferrecipient", req)
+}
+
+// BulkCreateTransferRecipients creates several transfer recipients at once.
+// It sends a POST request to the /transferrecipient/bulk endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a BulkCreateTransferRecipientsRequest struct containing the recipients.
+//
+// Returns:
+//   - A pointer to a BulkTransferRecipientsResponse struct containing the created recipients and any failures.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) BulkCreateTransferRecipients(ctx context.Context, req *BulkCreateTransferRecipientsRequest) (*BulkTransferRecipientsResponse, error) {
+	return call[BulkTransferRecipients](ctx, c, "POST", "/transferrecipient/bulk", req)
+}
+
+// ListTransferRecipients retrieves the transfer recipients available on the integration.
+// It sends a GET request to the /transferrecipient endpoint with the request encoded as query parameters.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a ListTransferRecipientsRequest struct containing the request parameters, or nil.
+//
+// Returns:
+//   - A pointer to a ListTransferRecipientsResponse struct containing the recipients.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ListTransferRecipients(ctx context.Context, req *ListTransferRecipientsRequest) (*ListTransferRecipientsResponse, error) {
+	path, err := withQuery("/transferrecipient", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return call[[]TransferRecipient](ctx, c, "GET", path, nil)
+}
+
+// IterateTransferRecipients returns an iterator over every transfer
+// recipient matching req, walking the pages of ListTransferRecipients
+// lazily. Iteration starts at req.Page (or the first page) and stops early
+// if ctx is cancelled.
+func (c *Client) IterateTransferRecipients(ctx context.Context, req *ListTransferRecipientsRequest) *Iter[TransferRecipient] {
+	params := ListTransferRecipientsRequest{}
+	if req != nil {
+		params = *req
+	}
+
+	return newIter(ctx, params.Page, func(ctx context.Context, page int) ([]TransferRecipient, *Meta, error) {
+		params.Page = page
+		response, err := c.ListTransferRecipients(ctx, &params)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, response.Meta, nil
+	})
+}
+
+// FetchTransferRecipient retrieves a transfer recipient by its ID or code.
+// It sends a GET request to the /transferrecipient/:id_or_code endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - idOrCode: The ID or recipient code of the recipient.
+//
+// Returns:
+//   - A pointer to a TransferRecipientResponse struct containing the recipient.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) FetchTransferRecipient(ctx context.Context, idOrCode string) (*TransferRecipientResponse, error) {
+	return call[TransferRecipient](ctx, c, "GET", pathf("/transferrecipient/%s", idOrCode), nil)
+}
+
+// UpdateTransferRecipient updates the name and email of a transfer recipient.
+// It sends a PUT request to the /transferrecipient/:id_or_code endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - idOrCode: The ID or recipient code of the recipient.
+//   - req: A pointer to an UpdateTransferRecipientRequest struct containing the fields to update.
+//
+// Returns:
+//   - A pointer to a MessageResponse struct acknowledging the update.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) UpdateTransferRecipient(ctx context.Context, idOrCode string, req *UpdateTransferRecipientRequest) (*MessageResponse, error) {
+	return call[interface{}](ctx, c, "PUT", pathf("/transferrecipient/%s", idOrCode), req)
+}
+
+// DeleteTransferRecipient deactivates a transfer recipient so it can no longer receive transfers.
+// It sends a DELETE request to the /transferrecipient/:id_or_code endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - idOrCode: The ID or recipient code of the recipient.
+//
+// Returns:
+//   - A pointer to a MessageResponse struct acknowledging the deletion.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) DeleteTransferRecipient(ctx context.Context, idOrCode string) (*MessageResponse, error) {
+	return call[interface{}](ctx, c, "DELETE", pathf("/transferrecipient/%s", idOrCode), nil)
+}
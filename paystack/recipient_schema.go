@@ -0,0 +1,160 @@
+package paystack
+
+import (
+	"fmt"
+	"time"
+)
+
+// RecipientType is the kind of account a transfer recipient is paid into.
+type RecipientType string
+
+// Recipient types supported by Paystack.
+const (
+	// RecipientTypeNUBAN is a Nigerian bank account.
+	RecipientTypeNUBAN RecipientType = "nuban"
+	// RecipientTypeMobileMoney is a mobile money wallet, e.g. in Ghana or Kenya.
+	RecipientTypeMobileMoney RecipientType = "mobile_money"
+	// RecipientTypeBASA is a South African bank account.
+	RecipientTypeBASA RecipientType = "basa"
+	// RecipientTypeAuthorization pays out to the card behind an authorization code.
+	RecipientTypeAuthorization RecipientType = "authorization"
+)
+
+// IsValid reports whether the recipient type is one Paystack supports.
+func (t RecipientType) IsValid() bool {
+	switch t {
+	case RecipientTypeNUBAN, RecipientTypeMobileMoney, RecipientTypeBASA, RecipientTypeAuthorization:
+		return true
+	}
+
+	return false
+}
+
+// TransferRecipient represents a beneficiary that transfers can be sent to.
+type TransferRecipient struct {
+	ID            int                    `json:"id"`
+	Domain        string                 `json:"domain"`
+	Type          RecipientType          `json:"type"`
+	Currency      Currency               `json:"currency"`
+	Name          string                 `json:"name"`
+	Email         string                 `json:"email"`
+	Description   string                 `json:"description"`
+	RecipientCode string                 `json:"recipient_code"`
+	Active        bool                   `json:"active"`
+	IsDeleted     bool                   `json:"is_deleted"`
+	Integration   int                    `json:"integration"`
+	Metadata      map[string]interface{} `json:"metadata"`
+	Details       RecipientDetails       `json:"details"`
+	CreatedAt     string                 `json:"createdAt"`
+	UpdatedAt     string                 `json:"updatedAt"`
+}
+
+// RecipientDetails represents the account details of a transfer recipient.
+type RecipientDetails struct {
+	AuthorizationCode string `json:"authorization_code"`
+	AccountNumber     string `json:"account_number"`
+	AccountName       string `json:"account_name"`
+	BankCode          string `json:"bank_code"`
+	BankName          string `json:"bank_name"`
+}
+
+// CreateTransferRecipientRequest represents the body parameters for the CreateTransferRecipient API.
+// Bank and mobile money recipients need AccountNumber and BankCode;
+// authorization recipients need AuthorizationCode and Email.
+type CreateTransferRecipientRequest struct {
+	Type              RecipientType          `json:"type"`
+	Name              string                 `json:"name"`
+	AccountNumber     string                 `json:"account_number,omitempty"`
+	BankCode          string                 `json:"bank_code,omitempty"`
+	AuthorizationCode string                 `json:"authorization_code,omitempty"`
+	Email             string                 `json:"email,omitempty"`
+	Description       string                 `json:"description,omitempty"`
+	Currency          Currency               `json:"currency,omitempty"`
+	Metadata          map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *CreateTransferRecipientRequest) Validate() error {
+	v := validation{}
+	v.check(r.Type.IsValid(), "type", fmt.Sprintf("unsupported recipient type %q", r.Type))
+	v.required(r.Name, "name")
+	v.currency(r.Currency, "currency")
+	switch r.Type {
+	case RecipientTypeAuthorization:
+		v.required(r.AuthorizationCode, "authorization_code")
+		v.email(r.Email, "email")
+	case RecipientTypeNUBAN, RecipientTypeMobileMoney, RecipientTypeBASA:
+		v.required(r.AccountNumber, "account_number")
+		v.required(r.BankCode, "bank_code")
+	}
+
+	return v.err()
+}
+
+// TransferRecipientResponse represents the response body for the CreateTransferRecipient and
+// FetchTransferRecipient APIs.
+type TransferRecipientResponse = Response[TransferRecipient]
+
+// BulkCreateTransferRecipientsRequest represents the body parameters for the
+// BulkCreateTransferRecipients API.
+type BulkCreateTransferRecipientsRequest struct {
+	Batch []CreateTransferRecipientRequest `json:"batch"`
+}
+
+// Validate checks the request before it is sent.
+func (r *BulkCreateTransferRecipientsRequest) Validate() error {
+	v := validation{}
+	v.check(len(r.Batch) > 0, "batch", "is required")
+	for i := range r.Batch {
+		v.nested(fmt.Sprintf("batch[%d]", i), r.Batch[i].Validate())
+	}
+
+	return v.err()
+}
+
+// BulkTransferRecipients represents the outcome of the BulkCreateTransferRecipients API.
+type BulkTransferRecipients struct {
+	Success []TransferRecipient `json:"success"`
+	// Errors holds the entries Paystack could not create, as returned by the API.
+	Errors []interface{} `json:"errors"`
+}
+
+// BulkTransferRecipientsResponse represents the response body for the BulkCreateTransferRecipients API.
+type BulkTransferRecipientsResponse = Response[BulkTransferRecipients]
+
+// ListTransferRecipientsRequest represents the query parameters for the ListTransferRecipients API.
+type ListTransferRecipientsRequest struct {
+	PerPage int       `url:"perPage,omitempty"`
+	Page    int       `url:"page,omitempty"`
+	From    time.Time `url:"from,omitempty"`
+	To      time.Time `url:"to,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ListTransferRecipientsRequest) Validate() error {
+	v := validation{}
+	v.paging(r.PerPage, r.Page)
+	v.dateRange(r.From, r.To)
+
+	return v.err()
+}
+
+// ListTransferRecipientsResponse represents the response body for the ListTransferRecipients API.
+type ListTransferRecipientsResponse = Response[[]TransferRecipient]
+
+// UpdateTransferRecipientRequest represents the body parameters for the UpdateTransferRecipient API.
+type UpdateTransferRecipientRequest struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *UpdateTransferRecipientRequest) Validate() error {
+	v := validation{}
+	v.required(r.Name, "name")
+	if r.Email != "" {
+		v.email(r.Email, "email")
+	}
+
+	return v.err()
+}
@@ -0,0 +1,72 @@
+package paystack
+
+import (
+	"context"
+)
+
+// CreateRefund refunds a transaction in full or, when an amount is given, in part.
+// It sends a POST request to the /refund endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a CreateRefundRequest struct containing the refund details.
+//
+// Returns:
+//   - A pointer to a RefundResponse struct containing the refund.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) CreateRefund(ctx context.Context, req *CreateRefundRequest) (*RefundResponse, error) {
+	return call[Refund](ctx, c, "POST", "/refund", req)
+}
+
+// ListRefunds retrieves the refunds made on the integration.
+// It sends a GET request to the /refund endpoint with the request encoded as query parameters.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a ListRefundsRequest struct containing the request parameters, or nil.
+//
+// Returns:
+//   - A pointer to a ListRefundsResponse struct containing the refunds.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ListRefunds(ctx context.Context, req *ListRefundsRequest) (*ListRefundsResponse, error) {
+	path, err := withQuery("/refund", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return call[[]Refund](ctx, c, "GET", path, nil)
+}
+
+// IterateRefunds returns an iterator over every refund matching req,
+// walking the pages of ListRefunds lazily. Iteration starts at req.Page
+// (or the first page) and stops early if ctx is cancelled.
+func (c *Client) IterateRefunds(ctx context.Context, req *ListRefundsRequest) *Iter[Refund] {
+	params := ListRefundsRequest{}
+	if req != nil {
+		params = *req
+	}
+
+	return newIter(ctx, params.Page, func(ctx context.Context, page int) ([]Refund, *Meta, error) {
+		params.Page = page
+		response, err := c.ListRefunds(ctx, &params)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, response.Meta, nil
+	})
+}
+
+// FetchRefund retrieves a refund by its ID.
+// It sends a GET request to the /refund/:id endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - id: The ID of the refund.
+//
+// Returns:
+//   - A pointer to a RefundResponse struct containing the refund.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) FetchRefund(ctx context.Context, id string) (*RefundResponse, error) {
+	return call[Refund](ctx, c, "GET", pathf("/refund/%s", id), nil)
+}
@@ -0,0 +1,133 @@
+package paystack
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// RefundStatus is the state of a refund.
+type RefundStatus string
+
+// Refund statuses returned by Paystack.
+const (
+	RefundStatusPending    RefundStatus = "pending"
+	RefundStatusProcessing RefundStatus = "processing"
+	RefundStatusProcessed  RefundStatus = "processed"
+	RefundStatusFailed     RefundStatus = "failed"
+)
+
+// Refund represents a refund of a transaction.
+type Refund struct {
+	ID          int    `json:"id"`
+	Integration int    `json:"integration"`
+	Domain      string `json:"domain"`
+	// Transaction only carries its ID when returned by the ListRefunds and
+	// FetchRefund APIs.
+	Transaction Transaction `json:"transaction"`
+	Dispute     int         `json:"dispute"`
+	// Amount is the refunded amount and DeductedAmount what has been taken
+	// from the balance so far, both in minor units of Currency.
+	Amount         Money        `json:"amount"`
+	DeductedAmount Money        `json:"deducted_amount"`
+	FullyDeducted  bool         `json:"fully_deducted"`
+	Currency       Currency     `json:"currency"`
+	Channel        string       `json:"channel"`
+	Status         RefundStatus `json:"status"`
+	RefundedBy     string       `json:"refunded_by"`
+	RefundedAt     string       `json:"refunded_at"`
+	ExpectedAt     string       `json:"expected_at"`
+	CustomerNote   string       `json:"customer_note"`
+	MerchantNote   string       `json:"merchant_note"`
+	CreatedAt      string       `json:"createdAt"`
+	UpdatedAt      string       `json:"updatedAt"`
+}
+
+// UnmarshalJSON decodes a refund whose transaction may be either an object
+// or a bare ID, and sets the currency of its amounts.
+func (r *Refund) UnmarshalJSON(data []byte) error {
+	type refund Refund
+	aux := struct {
+		*refund
+		Transaction json.RawMessage `json:"transaction"`
+	}{refund: (*refund)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if err := decodeObjectOrID(aux.Transaction, &r.Transaction, &r.Transaction.ID); err != nil {
+		return err
+	}
+
+	r.Amount.Currency = r.Currency
+	r.DeductedAmount.Currency = r.Currency
+	return nil
+}
+
+// IsProcessed reports whether the refund has reached the customer.
+func (r *Refund) IsProcessed() bool {
+	return r.Status == RefundStatusProcessed
+}
+
+// CreateRefundRequest represents the body parameters for the CreateRefund API.
+type CreateRefundRequest struct {
+	// Transaction is the ID or reference of the transaction to refund.
+	Transaction string `json:"transaction"`
+	// Amount is the amount to refund for a partial refund; the whole
+	// transaction is refunded when it is nil.
+	Amount       *Money   `json:"amount,omitempty"`
+	Currency     Currency `json:"currency,omitempty"`
+	CustomerNote string   `json:"customer_note,omitempty"`
+	MerchantNote string   `json:"merchant_note,omitempty"`
+}
+
+// MarshalJSON fills in the currency from Amount when Currency is not set.
+func (r CreateRefundRequest) MarshalJSON() ([]byte, error) {
+	type request CreateRefundRequest
+	if r.Currency == "" && r.Amount != nil {
+		r.Currency = r.Amount.Currency
+	}
+
+	return json.Marshal(request(r))
+}
+
+// Validate checks the request before it is sent.
+func (r *CreateRefundRequest) Validate() error {
+	v := validation{}
+	v.required(r.Transaction, "transaction")
+	if r.Amount != nil {
+		v.positive(r.Amount.Amount, "amount")
+		v.currency(r.Amount.Currency, "amount")
+		v.check(r.Currency == "" || r.Amount.Currency == "" || r.Currency == r.Amount.Currency,
+			"currency", "does not match the currency of amount")
+	}
+	v.currency(r.Currency, "currency")
+
+	return v.err()
+}
+
+// RefundResponse represents the response body for the CreateRefund and FetchRefund APIs.
+type RefundResponse = Response[Refund]
+
+// ListRefundsRequest represents the query parameters for the ListRefunds API.
+type ListRefundsRequest struct {
+	PerPage int `url:"perPage,omitempty"`
+	Page    int `url:"page,omitempty"`
+	// Transaction filters by the ID or reference of the refunded transaction.
+	Transaction string    `url:"transaction,omitempty"`
+	Currency    Currency  `url:"currency,omitempty"`
+	From        time.Time `url:"from,omitempty"`
+	To          time.Time `url:"to,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ListRefundsRequest) Validate() error {
+	v := validation{}
+	v.paging(r.PerPage, r.Page)
+	v.currency(r.Currency, "currency")
+	v.dateRange(r.From, r.To)
+
+	return v.err()
+}
+
+// ListRefundsResponse represents the response body for the ListRefunds API.
+type ListRefundsResponse = Response[[]Refund]
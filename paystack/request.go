@@ -0,0 +1,106 @@
+package paystack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// call sends a request through the client and decodes the response
+// envelope into a Response carrying a T.
+func call[T any](ctx context.Context, c *Client, method, path string, body interface{}) (*Response[T], error) {
+	var response Response[T]
+	if err := c.do(ctx, method, path, body, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// pathf formats a request path, escaping each argument as a single path
+// segment so that IDs, emails and references containing '/', '?' or '#'
+// cannot change which URL is requested.
+func pathf(format string, segments ...string) string {
+	args := make([]interface{}, len(segments))
+	for i, segment := range segments {
+		args[i] = url.PathEscape(segment)
+	}
+
+	return fmt.Sprintf(format, args...)
+}
+
+// do is the single request executor used by every endpoint. It validates
+// body, marshals it (when not nil) as JSON, sends the request, retrying transient
+// failures according to the client's RetryPolicy, turns non-2xx responses
+// into an *APIError and unmarshals the response body into out.
+func (c *Client) do(ctx context.Context, method, path string, body, out interface{}) error {
+	if err := validate(body); err != nil {
+		return err
+	}
+
+	var payload []byte
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshalling request: %w", err)
+		}
+		payload = data
+	}
+
+	retryable := c.retryPolicy.canRetry(ctx, method)
+
+	for attempt := 1; ; attempt++ {
+		response, responseBody, err := c.send(ctx, method, path, payload)
+
+		if retryable && attempt < c.retryPolicy.MaxAttempts && shouldRetry(ctx, response, err) {
+			if err := sleep(ctx, c.retryPolicy.backoff(attempt, response)); err != nil {
+				return fmt.Errorf("error making request: %w", err)
+			}
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if response.StatusCode < 200 || response.StatusCode > 299 {
+			return newAPIError(response, responseBody)
+		}
+
+		if err := json.Unmarshal(responseBody, out); err != nil {
+			return fmt.Errorf("error parsing response: %w", err)
+		}
+
+		return nil
+	}
+}
+
+// send performs a single attempt of a request and reads the response body.
+func (c *Client) send(ctx context.Context, method, path string, payload []byte) (*http.Response, []byte, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	request, err := c.newRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, nil, &transportError{fmt.Errorf("error making request: %w", err)}
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, nil, &transportError{fmt.Errorf("error reading response body: %w", err)}
+	}
+
+	return response, responseBody, nil
+}
@@ -0,0 +1,27 @@
+package paystack
+
+// Response is the envelope Paystack wraps every payload in. Data holds the
+// endpoint specific payload and Meta is only set by list endpoints.
+type Response[T any] struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    T      `json:"data"`
+	Meta    *Meta  `json:"meta,omitempty"`
+}
+
+// Meta represents the pagination block returned by list endpoints.
+type Meta struct {
+	Total     int `json:"total"`
+	Skipped   int `json:"skipped"`
+	PerPage   int `json:"perPage"`
+	Page      int `json:"page"`
+	PageCount int `json:"pageCount"`
+	// Next and Previous are the cursors returned by endpoints that support
+	// cursor based pagination; they are empty otherwise.
+	Next     string `json:"next,omitempty"`
+	Previous string `json:"previous,omitempty"`
+}
+
+// MessageResponse represents a response that only carries a status and
+// message, such as the acknowledgement of a removal.
+type MessageResponse = Response[interface{}]
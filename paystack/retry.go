@@ -0,0 +1,160 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the client retries transient failures: network
+// errors and 429, 500, 502, 503 and 504 responses. Only idempotent requests
+// (GET, HEAD, PUT and DELETE) are retried unless the call's context was
+// wrapped with RetryableContext.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. It doubles with
+	// every further attempt, up to MaxBackoff.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts, including delays asked
+	// for by a Retry-After header. Zero means no cap.
+	MaxBackoff time.Duration
+	// Jitter is the fraction, between 0 and 1, of each delay that is
+	// randomised to spread out retries from concurrent callers.
+	Jitter float64
+}
+
+// DefaultRetryPolicy is a reasonable policy for production use. Clients do
+// not retry unless configured with WithRetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     10 * time.Second,
+	Jitter:         0.5,
+}
+
+// WithRetryPolicy sets the policy used to retry transient failures.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+type retryableKey struct{}
+
+// RetryableContext returns a copy of ctx that marks calls made with it as
+// safe to retry even when they are not idempotent. Use it for POSTs that
+// carry an idempotency safeguard, such as a unique transaction reference
+// that Paystack rejects as a duplicate.
+func RetryableContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryableKey{}, true)
+}
+
+// canRetry reports whether a request with the given method may be retried
+// under the policy.
+func (p RetryPolicy) canRetry(ctx context.Context, method string) bool {
+	if p.MaxAttempts < 2 {
+		return false
+	}
+
+	switch method {
+	case "GET", "HEAD", "PUT", "DELETE":
+		return true
+	}
+
+	retryable, _ := ctx.Value(retryableKey{}).(bool)
+	return retryable
+}
+
+// transportError marks a failure to send a request or read its response,
+// as opposed to a failure to build the request in the first place.
+type transportError struct {
+	err error
+}
+
+func (e *transportError) Error() string {
+	return e.err.Error()
+}
+
+func (e *transportError) Unwrap() error {
+	return e.err
+}
+
+// shouldRetry reports whether the outcome of an attempt is a transient
+// failure worth retrying. Errors other than transport errors, such as a
+// malformed base URL, fail the same way on every attempt and are not retried.
+func shouldRetry(ctx context.Context, response *http.Response, err error) bool {
+	if err != nil {
+		var transport *transportError
+		return errors.As(err, &transport) && ctx.Err() == nil &&
+			!errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// backoff returns the delay before the given retry (1 for the first one),
+// preferring the server's Retry-After header when present.
+func (p RetryPolicy) backoff(retry int, response *http.Response) time.Duration {
+	delay, ok := retryAfter(response)
+	if !ok {
+		delay = p.InitialBackoff
+		for i := 1; i < retry && (p.MaxBackoff <= 0 || delay < p.MaxBackoff); i++ {
+			delay *= 2
+		}
+		if p.Jitter > 0 {
+			delay -= time.Duration(rand.Float64() * min(p.Jitter, 1) * float64(delay))
+		}
+	}
+
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	return delay
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as
+// an HTTP date.
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// sleep waits for d or until ctx is done, whichever comes first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
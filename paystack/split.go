@@ -0,0 +1,120 @@
+package paystack
+
+import (
+	"context"
+)
+
+// CreateSplit creates a split that shares the payments of a transaction
+// between the integration and one or more subaccounts.
+// It sends a POST request to the /split endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a CreateSplitRequest struct containing the split details.
+//
+// Returns:
+//   - A pointer to a SplitResponse struct containing the created split.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) CreateSplit(ctx context.Context, req *CreateSplitRequest) (*SplitResponse, error) {
+	return call[Split](ctx, c, "POST", "/split", req)
+}
+
+// ListSplits retrieves the splits available on the integration.
+// It sends a GET request to the /split endpoint with the request encoded as query parameters.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a ListSplitsRequest struct containing the request parameters, or nil.
+//
+// Returns:
+//   - A pointer to a ListSplitsResponse struct containing the splits.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ListSplits(ctx context.Context, req *ListSplitsRequest) (*ListSplitsResponse, error) {
+	path, err := withQuery("/split", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return call[[]Split](ctx, c, "GET", path, nil)
+}
+
+// IterateSplits returns an iterator over every split matching req,
+// walking the pages of ListSplits lazily. Iteration starts at req.Page
+// (or the first page) and stops early if ctx is cancelled.
+func (c *Client) IterateSplits(ctx context.Context, req *ListSplitsRequest) *Iter[Split] {
+	params := ListSplitsRequest{}
+	if req != nil {
+		params = *req
+	}
+
+	return newIter(ctx, params.Page, func(ctx context.Context, page int) ([]Split, *Meta, error) {
+		params.Page = page
+		response, err := c.ListSplits(ctx, &params)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, response.Meta, nil
+	})
+}
+
+// FetchSplit retrieves a split by its ID.
+// It sends a GET request to the /split/:id endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - id: The ID of the split.
+//
+// Returns:
+//   - A pointer to a SplitResponse struct containing the split.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) FetchSplit(ctx context.Context, id string) (*SplitResponse, error) {
+	return call[Split](ctx, c, "GET", pathf("/split/%s", id), nil)
+}
+
+// UpdateSplit updates the name, status or charge bearer of a split.
+// It sends a PUT request to the /split/:id endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - id: The ID of the split.
+//   - req: A pointer to an UpdateSplitRequest struct containing the fields to update.
+//
+// Returns:
+//   - A pointer to a SplitResponse struct containing the updated split.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) UpdateSplit(ctx context.Context, id string, req *UpdateSplitRequest) (*SplitResponse, error) {
+	return call[Split](ctx, c, "PUT", pathf("/split/%s", id), req)
+}
+
+// AddOrUpdateSplitSubaccount adds a subaccount to a split, or updates its
+// share when it is already part of the split.
+// It sends a POST request to the /split/:id/subaccount/add endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - id: The ID of the split.
+//   - req: A pointer to a SplitSubaccount struct containing the subaccount code and share.
+//
+// Returns:
+//   - A pointer to a SplitResponse struct containing the updated split.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) AddOrUpdateSplitSubaccount(ctx context.Context, id string, req *SplitSubaccount) (*SplitResponse, error) {
+	return call[Split](ctx, c, "POST", pathf("/split/%s/subaccount/add", id), req)
+}
+
+// RemoveSplitSubaccount removes a subaccount from a split.
+// It sends a POST request to the /split/:id/subaccount/remove endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - id: The ID of the split.
+//   - subaccountCode: The code of the subaccount to remove.
+//
+// Returns:
+//   - A pointer to a MessageResponse struct acknowledging the removal.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) RemoveSplitSubaccount(ctx context.Context, id, subaccountCode string) (*MessageResponse, error) {
+	body := &removeSplitSubaccountRequest{Subaccount: subaccountCode}
+	return call[interface{}](ctx, c, "POST", pathf("/split/%s/subaccount/remove", id), body)
+}
@@ -0,0 +1,197 @@
+package paystack
+
+import (
+	"fmt"
+	"time"
+)
+
+// SplitType is how the shares of a split are expressed.
+type SplitType string
+
+// Split types supported by Paystack.
+const (
+	SplitTypePercentage SplitType = "percentage"
+	SplitTypeFlat       SplitType = "flat"
+)
+
+// SplitBearerType is who bears the Paystack charges of a split payment.
+type SplitBearerType string
+
+// Split bearer types supported by Paystack.
+const (
+	SplitBearerSubaccount      SplitBearerType = "subaccount"
+	SplitBearerAccount         SplitBearerType = "account"
+	SplitBearerAllProportional SplitBearerType = "all-proportional"
+	SplitBearerAll             SplitBearerType = "all"
+)
+
+// IsValid reports whether the bearer type is one Paystack supports.
+func (b SplitBearerType) IsValid() bool {
+	switch b {
+	case SplitBearerSubaccount, SplitBearerAccount, SplitBearerAllProportional, SplitBearerAll:
+		return true
+	}
+
+	return false
+}
+
+// SplitSubaccount represents a subaccount and its share in a split.
+// Share is a percentage for percentage splits and an amount in the minor
+// unit of the split's currency for flat splits.
+type SplitSubaccount struct {
+	Subaccount string `json:"subaccount"`
+	Share      int    `json:"share"`
+}
+
+// Validate checks the request before it is sent.
+func (r *SplitSubaccount) Validate() error {
+	v := validation{}
+	v.required(r.Subaccount, "subaccount")
+	v.positive(int64(r.Share), "share")
+
+	return v.err()
+}
+
+// CreateSplitRequest represents the body parameters for the CreateSplit API.
+type CreateSplitRequest struct {
+	Name             string            `json:"name"`
+	Type             SplitType         `json:"type"`
+	Currency         Currency          `json:"currency"`
+	Subaccounts      []SplitSubaccount `json:"subaccounts"`
+	BearerType       SplitBearerType   `json:"bearer_type"`
+	BearerSubaccount string            `json:"bearer_subaccount,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *CreateSplitRequest) Validate() error {
+	v := validation{}
+	v.required(r.Name, "name")
+	v.required(string(r.Currency), "currency")
+	v.currency(r.Currency, "currency")
+	v.splitShares(r.Type, r.Subaccounts)
+	v.splitBearer(r.BearerType, r.BearerSubaccount)
+
+	return v.err()
+}
+
+// DynamicSplit represents a split defined inline on a single transaction
+// instead of being created up front with CreateSplit.
+type DynamicSplit struct {
+	Type             SplitType         `json:"type"`
+	BearerType       SplitBearerType   `json:"bearer_type"`
+	BearerSubaccount string            `json:"bearer_subaccount,omitempty"`
+	Subaccounts      []SplitSubaccount `json:"subaccounts"`
+}
+
+// Validate checks the request before it is sent.
+func (r *DynamicSplit) Validate() error {
+	v := validation{}
+	v.splitShares(r.Type, r.Subaccounts)
+	v.splitBearer(r.BearerType, r.BearerSubaccount)
+
+	return v.err()
+}
+
+// splitShares checks the type of a split and the shares of its subaccounts.
+func (v *validation) splitShares(splitType SplitType, subaccounts []SplitSubaccount) {
+	v.check(splitType == SplitTypePercentage || splitType == SplitTypeFlat, "type",
+		fmt.Sprintf("unsupported split type %q", splitType))
+	v.check(len(subaccounts) > 0, "subaccounts", "must not be empty")
+
+	total := 0
+	for i := range subaccounts {
+		v.nested(fmt.Sprintf("subaccounts[%d]", i), subaccounts[i].Validate())
+		total += subaccounts[i].Share
+	}
+	v.check(splitType != SplitTypePercentage || total <= 100, "subaccounts", "percentage shares must not exceed 100")
+}
+
+// splitBearer checks who bears the charges of a split.
+func (v *validation) splitBearer(bearerType SplitBearerType, bearerSubaccount string) {
+	v.check(bearerType.IsValid(), "bearer_type", fmt.Sprintf("unsupported bearer type %q", bearerType))
+	v.check(bearerType != SplitBearerSubaccount || bearerSubaccount != "", "bearer_subaccount",
+		"is required when bearer_type is subaccount")
+}
+
+// Split represents a transaction split returned by the Paystack API.
+type Split struct {
+	ID               int                    `json:"id"`
+	Name             string                 `json:"name"`
+	Type             SplitType              `json:"type"`
+	Currency         Currency               `json:"currency"`
+	Integration      int                    `json:"integration"`
+	Domain           string                 `json:"domain"`
+	SplitCode        string                 `json:"split_code"`
+	Active           bool                   `json:"active"`
+	BearerType       SplitBearerType        `json:"bearer_type"`
+	IsDynamic        bool                   `json:"is_dynamic"`
+	Subaccounts      []SplitSubaccountShare `json:"subaccounts"`
+	TotalSubaccounts int                    `json:"total_subaccounts"`
+	CreatedAt        string                 `json:"createdAt"`
+	UpdatedAt        string                 `json:"updatedAt"`
+}
+
+// SplitSubaccountShare represents a subaccount and its share as returned on a split.
+type SplitSubaccountShare struct {
+	Subaccount Subaccount `json:"subaccount"`
+	Share      int        `json:"share"`
+}
+
+// SplitResponse represents the response body for the CreateSplit, FetchSplit,
+// UpdateSplit and AddOrUpdateSplitSubaccount APIs.
+type SplitResponse = Response[Split]
+
+// ListSplitsRequest represents the query parameters for the ListSplits API.
+type ListSplitsRequest struct {
+	Name string `url:"name,omitempty"`
+	// Active filters by whether the split is active; nil lists both.
+	Active  *bool     `url:"active,omitempty"`
+	SortBy  string    `url:"sort_by,omitempty"`
+	PerPage int       `url:"perPage,omitempty"`
+	Page    int       `url:"page,omitempty"`
+	From    time.Time `url:"from,omitempty"`
+	To      time.Time `url:"to,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ListSplitsRequest) Validate() error {
+	v := validation{}
+	v.paging(r.PerPage, r.Page)
+	v.dateRange(r.From, r.To)
+
+	return v.err()
+}
+
+// ListSplitsResponse represents the response body for the ListSplits API.
+type ListSplitsResponse = Response[[]Split]
+
+// UpdateSplitRequest represents the body parameters for the UpdateSplit API.
+type UpdateSplitRequest struct {
+	Name             string          `json:"name,omitempty"`
+	Active           *bool           `json:"active,omitempty"`
+	BearerType       SplitBearerType `json:"bearer_type,omitempty"`
+	BearerSubaccount string          `json:"bearer_subaccount,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *UpdateSplitRequest) Validate() error {
+	v := validation{}
+	if r.BearerType != "" {
+		v.splitBearer(r.BearerType, r.BearerSubaccount)
+	}
+
+	return v.err()
+}
+
+// removeSplitSubaccountRequest represents the body parameters for the RemoveSplitSubaccount API.
+type removeSplitSubaccountRequest struct {
+	Subaccount string `json:"subaccount"`
+}
+
+// Validate checks the request before it is sent.
+func (r *removeSplitSubaccountRequest) Validate() error {
+	v := validation{}
+	v.required(r.Subaccount, "subaccount")
+
+	return v.err()
+}
@@ -0,0 +1,87 @@
+package paystack
+
+import (
+	"context"
+)
+
+// CreateSubaccount creates a subaccount that can receive a share of payments.
+// It sends a POST request to the /subaccount endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a CreateSubaccountRequest struct containing the subaccount details.
+//
+// Returns:
+//   - A pointer to a SubaccountResponse struct containing the created subaccount.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) CreateSubaccount(ctx context.Context, req *CreateSubaccountRequest) (*SubaccountResponse, error) {
+	return call[Subaccount](ctx, c, "POST", "/subaccount", req)
+}
+
+// ListSubaccounts retrieves the subaccounts available on the integration.
+// It sends a GET request to the /subaccount endpoint with the request encoded as query parameters.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a ListSubaccountsRequest struct containing the request parameters, or nil.
+//
+// Returns:
+//   - A pointer to a ListSubaccountsResponse struct containing the subaccounts.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ListSubaccounts(ctx context.Context, req *ListSubaccountsRequest) (*ListSubaccountsResponse, error) {
+	path, err := withQuery("/subaccount", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return call[[]Subaccount](ctx, c, "GET", path, nil)
+}
+
+// IterateSubaccounts returns an iterator over every subaccount matching req,
+// walking the pages of ListSubaccounts lazily. Iteration starts at req.Page
+// (or the first page) and stops early if ctx is cancelled.
+func (c *Client) IterateSubaccounts(ctx context.Context, req *ListSubaccountsRequest) *Iter[Subaccount] {
+	params := ListSubaccountsRequest{}
+	if req != nil {
+		params = *req
+	}
+
+	return newIter(ctx, params.Page, func(ctx context.Context, page int) ([]Subaccount, *Meta, error) {
+		params.Page = page
+		response, err := c.ListSubaccounts(ctx, &params)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, response.Meta, nil
+	})
+}
+
+// FetchSubaccount retrieves a subaccount by its ID or code.
+// It sends a GET request to the /subaccount/:id_or_code endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - idOrCode: The ID or subaccount code of the subaccount.
+//
+// Returns:
+//   - A pointer to a SubaccountResponse struct containing the subaccount.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) FetchSubaccount(ctx context.Context, idOrCode string) (*SubaccountResponse, error) {
+	return call[Subaccount](ctx, c, "GET", pathf("/subaccount/%s", idOrCode), nil)
+}
+
+// UpdateSubaccount updates the details of a subaccount.
+// It sends a PUT request to the /subaccount/:id_or_code endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - idOrCode: The ID or subaccount code of the subaccount.
+//   - req: A pointer to an UpdateSubaccountRequest struct containing the fields to update.
+//
+// Returns:
+//   - A pointer to a SubaccountResponse struct containing the updated subaccount.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) UpdateSubaccount(ctx context.Context, idOrCode string, req *UpdateSubaccountRequest) (*SubaccountResponse, error) {
+	return call[Subaccount](ctx, c, "PUT", pathf("/subaccount/%s", idOrCode), req)
+}
@@ -0,0 +1,178 @@
+package paystack
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SettlementSchedule is how often a subaccount is paid out. Paystack
+// returns it upper-cased, e.g. "AUTO"; it is lower-cased when decoded so it
+// compares equal to the constants below.
+type SettlementSchedule string
+
+// Settlement schedules supported by Paystack.
+const (
+	SettlementScheduleAuto    SettlementSchedule = "auto"
+	SettlementScheduleWeekly  SettlementSchedule = "weekly"
+	SettlementScheduleMonthly SettlementSchedule = "monthly"
+	SettlementScheduleManual  SettlementSchedule = "manual"
+)
+
+// UnmarshalJSON decodes a schedule and lower-cases it.
+func (s *SettlementSchedule) UnmarshalJSON(data []byte) error {
+	var schedule string
+	if err := json.Unmarshal(data, &schedule); err != nil {
+		return err
+	}
+
+	*s = SettlementSchedule(strings.ToLower(schedule))
+	return nil
+}
+
+// IsValid reports whether the schedule is one Paystack supports, ignoring case.
+func (s SettlementSchedule) IsValid() bool {
+	switch SettlementSchedule(strings.ToLower(string(s))) {
+	case SettlementScheduleAuto, SettlementScheduleWeekly, SettlementScheduleMonthly, SettlementScheduleManual:
+		return true
+	}
+
+	return false
+}
+
+// ChargeBearer is who bears the Paystack charges of a payment made to a subaccount.
+type ChargeBearer string
+
+// Charge bearers supported by Paystack.
+const (
+	ChargeBearerAccount    ChargeBearer = "account"
+	ChargeBearerSubaccount ChargeBearer = "subaccount"
+)
+
+// chargeBearer checks the bearer of a payment made to a subaccount.
+func (v *validation) chargeBearer(bearer ChargeBearer, subaccount string) {
+	v.check(bearer == "" || bearer == ChargeBearerAccount || bearer == ChargeBearerSubaccount, "bearer",
+		fmt.Sprintf("unsupported bearer %q", bearer))
+	v.check(bearer != ChargeBearerSubaccount || subaccount != "", "subaccount",
+		"is required when bearer is subaccount")
+}
+
+// transactionCharge checks the flat charge the integration keeps from a
+// payment made to a subaccount.
+func (v *validation) transactionCharge(charge *Money, amount Money) {
+	if charge == nil {
+		return
+	}
+
+	v.check(charge.Amount > 0 && charge.Amount <= amount.Amount, "transaction_charge", "must be between zero and amount")
+	v.check(charge.Currency == "" || charge.Currency == amount.Currency,
+		"transaction_charge", "does not match the currency of amount")
+}
+
+// Subaccount represents a subaccount returned by the Paystack API.
+type Subaccount struct {
+	ID                  int                    `json:"id"`
+	SubaccountCode      string                 `json:"subaccount_code"`
+	BusinessName        string                 `json:"business_name"`
+	Description         string                 `json:"description"`
+	PrimaryContactName  string                 `json:"primary_contact_name"`
+	PrimaryContactEmail string                 `json:"primary_contact_email"`
+	PrimaryContactPhone string                 `json:"primary_contact_phone"`
+	Metadata            map[string]interface{} `json:"metadata"`
+	// PercentageCharge is the percentage of each payment the integration keeps.
+	PercentageCharge   float64            `json:"percentage_charge"`
+	SettlementBank     string             `json:"settlement_bank"`
+	AccountNumber      string             `json:"account_number"`
+	Currency           Currency           `json:"currency"`
+	SettlementSchedule SettlementSchedule `json:"settlement_schedule"`
+	Active             bool               `json:"active"`
+	IsVerified         bool               `json:"is_verified"`
+	Integration        int                `json:"integration"`
+	Domain             string             `json:"domain"`
+	CreatedAt          string             `json:"createdAt"`
+	UpdatedAt          string             `json:"updatedAt"`
+}
+
+// CreateSubaccountRequest represents the body parameters for the CreateSubaccount API.
+type CreateSubaccountRequest struct {
+	BusinessName string `json:"business_name"`
+	// SettlementBank is the bank code of the account payouts are sent to.
+	SettlementBank      string                 `json:"settlement_bank"`
+	AccountNumber       string                 `json:"account_number"`
+	PercentageCharge    float64                `json:"percentage_charge"`
+	Description         string                 `json:"description,omitempty"`
+	PrimaryContactEmail string                 `json:"primary_contact_email,omitempty"`
+	PrimaryContactName  string                 `json:"primary_contact_name,omitempty"`
+	PrimaryContactPhone string                 `json:"primary_contact_phone,omitempty"`
+	Metadata            map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *CreateSubaccountRequest) Validate() error {
+	v := validation{}
+	v.required(r.BusinessName, "business_name")
+	v.required(r.SettlementBank, "settlement_bank")
+	v.required(r.AccountNumber, "account_number")
+	v.percentage(r.PercentageCharge, "percentage_charge")
+	if r.PrimaryContactEmail != "" {
+		v.email(r.PrimaryContactEmail, "primary_contact_email")
+	}
+
+	return v.err()
+}
+
+// SubaccountResponse represents the response body for the CreateSubaccount,
+// FetchSubaccount and UpdateSubaccount APIs.
+type SubaccountResponse = Response[Subaccount]
+
+// ListSubaccountsRequest represents the query parameters for the ListSubaccounts API.
+type ListSubaccountsRequest struct {
+	PerPage int       `url:"perPage,omitempty"`
+	Page    int       `url:"page,omitempty"`
+	From    time.Time `url:"from,omitempty"`
+	To      time.Time `url:"to,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ListSubaccountsRequest) Validate() error {
+	v := validation{}
+	v.paging(r.PerPage, r.Page)
+	v.dateRange(r.From, r.To)
+
+	return v.err()
+}
+
+// ListSubaccountsResponse represents the response body for the ListSubaccounts API.
+type ListSubaccountsResponse = Response[[]Subaccount]
+
+// UpdateSubaccountRequest represents the body parameters for the UpdateSubaccount API.
+// Only the fields that are set are updated.
+type UpdateSubaccountRequest struct {
+	BusinessName        string                 `json:"business_name,omitempty"`
+	SettlementBank      string                 `json:"settlement_bank,omitempty"`
+	AccountNumber       string                 `json:"account_number,omitempty"`
+	Active              *bool                  `json:"active,omitempty"`
+	PercentageCharge    *float64               `json:"percentage_charge,omitempty"`
+	Description         string                 `json:"description,omitempty"`
+	PrimaryContactEmail string                 `json:"primary_contact_email,omitempty"`
+	PrimaryContactName  string                 `json:"primary_contact_name,omitempty"`
+	PrimaryContactPhone string                 `json:"primary_contact_phone,omitempty"`
+	SettlementSchedule  SettlementSchedule     `json:"settlement_schedule,omitempty"`
+	Metadata            map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *UpdateSubaccountRequest) Validate() error {
+	v := validation{}
+	if r.PercentageCharge != nil {
+		v.percentage(*r.PercentageCharge, "percentage_charge")
+	}
+	v.check(r.SettlementSchedule == "" || r.SettlementSchedule.IsValid(), "settlement_schedule",
+		fmt.Sprintf("unsupported settlement schedule %q", r.SettlementSchedule))
+	if r.PrimaryContactEmail != "" {
+		v.email(r.PrimaryContactEmail, "primary_contact_email")
+	}
+
+	return v.err()
+}
@@ -0,0 +1,134 @@
+package paystack
+
+import (
+	"context"
+)
+
+// CreateSubscription subscribes a customer to a plan.
+// It sends a POST request to the /subscription endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a CreateSubscriptionRequest struct containing the customer and plan.
+//
+// Returns:
+//   - A pointer to a SubscriptionResponse struct containing the created subscription.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) CreateSubscription(ctx context.Context, req *CreateSubscriptionRequest) (*SubscriptionResponse, error) {
+	return call[Subscription](ctx, c, "POST", "/subscription", req)
+}
+
+// ListSubscriptions retrieves the subscriptions available on the integration.
+// It sends a GET request to the /subscription endpoint with the request encoded as query parameters.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a ListSubscriptionsRequest struct containing the request parameters, or nil.
+//
+// Returns:
+//   - A pointer to a ListSubscriptionsResponse struct containing the subscriptions.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ListSubscriptions(ctx context.Context, req *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	path, err := withQuery("/subscription", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return call[[]Subscription](ctx, c, "GET", path, nil)
+}
+
+// IterateSubscriptions returns an iterator over every subscription matching
+// req, walking the pages of ListSubscriptions lazily. Iteration starts at
+// req.Page (or the first page) and stops early if ctx is cancelled.
+func (c *Client) IterateSubscriptions(ctx context.Context, req *ListSubscriptionsRequest) *Iter[Subscription] {
+	params := ListSubscriptionsRequest{}
+	if req != nil {
+		params = *req
+	}
+
+	return newIter(ctx, params.Page, func(ctx context.Context, page int) ([]Subscription, *Meta, error) {
+		params.Page = page
+		response, err := c.ListSubscriptions(ctx, &params)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, response.Meta, nil
+	})
+}
+
+// FetchSubscription retrieves a subscription by its ID or code.
+// It sends a GET request to the /subscription/:id_or_code endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - idOrCode: The ID or subscription code of the subscription.
+//
+// Returns:
+//   - A pointer to a SubscriptionResponse struct containing the subscription.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) FetchSubscription(ctx context.Context, idOrCode string) (*SubscriptionResponse, error) {
+	return call[Subscription](ctx, c, "GET", pathf("/subscription/%s", idOrCode), nil)
+}
+
+// EnableSubscription enables a subscription that was disabled.
+// It sends a POST request to the /subscription/enable endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - code: The subscription code.
+//   - emailToken: The email token of the subscription.
+//
+// Returns:
+//   - A pointer to a MessageResponse struct acknowledging the change.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) EnableSubscription(ctx context.Context, code, emailToken string) (*MessageResponse, error) {
+	body := &subscriptionTokenRequest{Code: code, Token: emailToken}
+	return call[interface{}](ctx, c, "POST", "/subscription/enable", body)
+}
+
+// DisableSubscription disables a subscription so the customer is no longer charged.
+// It sends a POST request to the /subscription/disable endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - code: The subscription code.
+//   - emailToken: The email token of the subscription.
+//
+// Returns:
+//   - A pointer to a MessageResponse struct acknowledging the change.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) DisableSubscription(ctx context.Context, code, emailToken string) (*MessageResponse, error) {
+	body := &subscriptionTokenRequest{Code: code, Token: emailToken}
+	return call[interface{}](ctx, c, "POST", "/subscription/disable", body)
+}
+
+// GenerateSubscriptionUpdateLink generates a link the customer can use to
+// update the card on a subscription.
+// It sends a GET request to the /subscription/:code/manage/link endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - code: The subscription code.
+//
+// Returns:
+//   - A pointer to a SubscriptionLinkResponse struct containing the link.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) GenerateSubscriptionUpdateLink(ctx context.Context, code string) (*SubscriptionLinkResponse, error) {
+	return call[SubscriptionLink](ctx, c, "GET", pathf("/subscription/%s/manage/link", code), nil)
+}
+
+// SendSubscriptionUpdateLink emails the customer a link to update the card
+// on a subscription.
+// It sends a POST request to the /subscription/:code/manage/email endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - code: The subscription code.
+//
+// Returns:
+//   - A pointer to a MessageResponse struct acknowledging the email.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) SendSubscriptionUpdateLink(ctx context.Context, code string) (*MessageResponse, error) {
+	return call[interface{}](ctx, c, "POST", pathf("/subscription/%s/manage/email", code), nil)
+}
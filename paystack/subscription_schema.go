@@ -0,0 +1,164 @@
+package paystack
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SubscriptionStatus is the state of a subscription.
+type SubscriptionStatus string
+
+// Subscription statuses returned by Paystack.
+const (
+	SubscriptionStatusActive      SubscriptionStatus = "active"
+	SubscriptionStatusNonRenewing SubscriptionStatus = "non-renewing"
+	SubscriptionStatusAttention   SubscriptionStatus = "attention"
+	SubscriptionStatusCompleted   SubscriptionStatus = "completed"
+	SubscriptionStatusCancelled   SubscriptionStatus = "cancelled"
+)
+
+// Subscription represents a customer's subscription to a plan.
+type Subscription struct {
+	ID               int                `json:"id"`
+	Domain           string             `json:"domain"`
+	Status           SubscriptionStatus `json:"status"`
+	SubscriptionCode string             `json:"subscription_code"`
+	// EmailToken is required, together with SubscriptionCode, to enable or
+	// disable the subscription.
+	EmailToken string `json:"email_token"`
+	// Amount is in minor units of the plan's currency.
+	Amount          Money  `json:"amount"`
+	CronExpression  string `json:"cron_expression"`
+	NextPaymentDate string `json:"next_payment_date"`
+	OpenInvoice     string `json:"open_invoice"`
+	InvoiceLimit    int    `json:"invoice_limit"`
+	PaymentsCount   int    `json:"payments_count"`
+	Quantity        int    `json:"quantity"`
+	// Customer and Plan only carry their ID when returned by the
+	// CreateSubscription API.
+	Customer      Customer      `json:"customer"`
+	Plan          Plan          `json:"plan"`
+	Authorization Authorization `json:"authorization"`
+	CancelledAt   string        `json:"cancelledAt"`
+	CreatedAt     string        `json:"createdAt"`
+	UpdatedAt     string        `json:"updatedAt"`
+}
+
+// UnmarshalJSON decodes a subscription whose customer, plan and
+// authorization may be either objects or bare IDs, and sets the currency of
+// its amount from the plan.
+func (s *Subscription) UnmarshalJSON(data []byte) error {
+	type subscription Subscription
+	aux := struct {
+		*subscription
+		Customer      json.RawMessage `json:"customer"`
+		Plan          json.RawMessage `json:"plan"`
+		Authorization json.RawMessage `json:"authorization"`
+	}{subscription: (*subscription)(s)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if err := decodeObjectOrID(aux.Customer, &s.Customer, &s.Customer.ID); err != nil {
+		return err
+	}
+	if err := decodeObjectOrID(aux.Plan, &s.Plan, &s.Plan.ID); err != nil {
+		return err
+	}
+	if len(aux.Authorization) > 0 && aux.Authorization[0] == '{' {
+		if err := json.Unmarshal(aux.Authorization, &s.Authorization); err != nil {
+			return err
+		}
+	}
+
+	s.Amount.Currency = s.Plan.Currency
+	return nil
+}
+
+// decodeObjectOrID decodes raw into object when it is a JSON object and into
+// id when it is a number.
+func decodeObjectOrID(raw json.RawMessage, object interface{}, id *int) error {
+	switch {
+	case len(raw) == 0 || string(raw) == "null":
+		return nil
+	case raw[0] == '{':
+		return json.Unmarshal(raw, object)
+	default:
+		return json.Unmarshal(raw, id)
+	}
+}
+
+// IsActive reports whether the subscription will charge the customer again.
+func (s *Subscription) IsActive() bool {
+	return s.Status == SubscriptionStatusActive
+}
+
+// CreateSubscriptionRequest represents the body parameters for the CreateSubscription API.
+type CreateSubscriptionRequest struct {
+	// Customer is the email address or customer code of the customer.
+	Customer string `json:"customer"`
+	// Plan is the code of the plan to subscribe the customer to.
+	Plan string `json:"plan"`
+	// Authorization is the authorization code to charge; the customer's most
+	// recent authorization is used when it is empty.
+	Authorization string `json:"authorization,omitempty"`
+	// StartDate sets the date of the first debit; it defaults to now.
+	StartDate *time.Time `json:"start_date,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *CreateSubscriptionRequest) Validate() error {
+	v := validation{}
+	v.required(r.Customer, "customer")
+	v.required(r.Plan, "plan")
+
+	return v.err()
+}
+
+// SubscriptionResponse represents the response body for the CreateSubscription and FetchSubscription APIs.
+type SubscriptionResponse = Response[Subscription]
+
+// ListSubscriptionsRequest represents the query parameters for the ListSubscriptions API.
+type ListSubscriptionsRequest struct {
+	PerPage int `url:"perPage,omitempty"`
+	Page    int `url:"page,omitempty"`
+	// Customer filters by customer ID.
+	Customer int `url:"customer,omitempty"`
+	// Plan filters by plan ID.
+	Plan int `url:"plan,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ListSubscriptionsRequest) Validate() error {
+	v := validation{}
+	v.paging(r.PerPage, r.Page)
+
+	return v.err()
+}
+
+// ListSubscriptionsResponse represents the response body for the ListSubscriptions API.
+type ListSubscriptionsResponse = Response[[]Subscription]
+
+// subscriptionTokenRequest represents the body parameters for the
+// EnableSubscription and DisableSubscription APIs.
+type subscriptionTokenRequest struct {
+	Code  string `json:"code"`
+	Token string `json:"token"`
+}
+
+// Validate checks the request before it is sent.
+func (r *subscriptionTokenRequest) Validate() error {
+	v := validation{}
+	v.required(r.Code, "code")
+	v.required(r.Token, "token")
+
+	return v.err()
+}
+
+// SubscriptionLink represents the link a customer can use to update the card on a subscription.
+type SubscriptionLink struct {
+	Link string `json:"link"`
+}
+
+// SubscriptionLinkResponse represents the response body for the GenerateSubscriptionUpdateLink API.
+type SubscriptionLinkResponse = Response[SubscriptionLink]
@@ -1,19 +1,14 @@
 package paystack
 
 import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"io/ioutil"
-	"net/http"
-
-	"github.com/aglili/gopaystack/config"
+	"context"
 )
 
 // InitializeTransaction initializes a new transaction with the provided request data.
 // It sends a POST request to the Paystack API to create the transaction.
 //
 // Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
 //   - req: A pointer to an InitializeTransactionRequest struct containing the transaction details.
 //
 // Returns:
@@ -25,201 +20,174 @@ import (
 //   - If the HTTP request cannot be created.
 //   - If the HTTP request fails.
 //   - If the response body cannot be read.
-//   - If the API returns a non-200 status code.
+//   - If the API returns a non-200 status code (an *APIError).
 //   - If the response body cannot be unmarshalled to a TransactionResponse struct.
-func (c *Client) InitializeTransaction(req *InitializeTransactionRequest) (*TransactionResponse, error) {
-	url := config.BaseURL + "/transaction/initialize"
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling request: %v", err)
-	}
-
-	//create a new request
-	request, err := http.NewRequest("POST", url, bytes.NewBuffer(payload))
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
-	}
-
-	//set the request headers
-	request.Header.Set("Authorization", "Bearer "+c.secretKey)
-	request.Header.Set("Content-Type", "application/json")
-
-	// make the request
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
-	}
-	defer response.Body.Close()
-
-	//decode the response
-	body, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %v", err)
-	}
-
-	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error: %s", body)
-	}
+func (c *Client) InitializeTransaction(ctx context.Context, req *InitializeTransactionRequest) (*TransactionResponse, error) {
+	return call[InitializedTransaction](ctx, c, "POST", "/transaction/initialize", req)
+}
 
-	// parse the response
-	var transactionResponse TransactionResponse
-	err = json.Unmarshal(body, &transactionResponse)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
-	}
+// PartialDebit debits as much of Amount as the customer's balance allows from
+// a reusable authorization, never less than AtLeast when it is set.
+// It sends a POST request to the /transaction/partial_debit endpoint.
+//
+// As with ChargeAuthorization, check the status of the returned transaction
+// to learn whether the debit succeeded; Amount on it is what was collected.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a PartialDebitRequest struct containing the debit details.
+//
+// Returns:
+//   - A pointer to a PartialDebitResponse struct containing the resulting transaction.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) PartialDebit(ctx context.Context, req *PartialDebitRequest) (*PartialDebitResponse, error) {
+	return call[Transaction](ctx, c, "POST", "/transaction/partial_debit", req)
+}
 
-	return &transactionResponse, nil
+// ChargeAuthorization charges a reusable authorization, such as a card saved
+// from an earlier transaction, without redirecting the customer.
+// It sends a POST request to the /transaction/charge_authorization endpoint.
+//
+// A nil error does not mean the charge succeeded: check Data.Status, or the
+// IsSuccessful, RequiresOTP and IsPending helpers, on the returned transaction.
+// Charges carrying a unique Reference may be retried safely by passing a
+// context wrapped with RetryableContext.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a ChargeAuthorizationRequest struct containing the charge details.
+//
+// Returns:
+//   - A pointer to a ChargeAuthorizationResponse struct containing the resulting transaction.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ChargeAuthorization(ctx context.Context, req *ChargeAuthorizationRequest) (*ChargeAuthorizationResponse, error) {
+	return call[Transaction](ctx, c, "POST", "/transaction/charge_authorization", req)
 }
 
 // VerifyTransaction verifies a transaction on Paystack using the provided reference.
 // It sends a GET request to the Paystack API and returns the transaction details.
 //
 // Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
 //   - reference: The reference string of the transaction to be verified.
 //
 // Returns:
-//   - *schema.VerifyTransactionResponse: The response containing the transaction details.
+//   - *VerifyTransactionResponse: The response containing the transaction details.
 //   - error: An error object if an error occurred during the request or response parsing.
-func (c *Client) VerifyTransaction(reference string) (*VerifyTransactionResponse, error) {
-	url := fmt.Sprintf("%s/transaction/verify/%s", config.BaseURL, reference)
-
-	//create a new request
-	request, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
-	}
-
-	//set the request headers
-	request.Header.Set("Authorization", "Bearer "+c.secretKey)
-	request.Header.Set("Content-Type", "application/json")
-
-	// make the request
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
-	}
-	defer response.Body.Close()
-
-	//decode the response
-	body, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %v", err)
-	}
-
-	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error: %s", body)
-	}
-
-	// parse the response
-	var transactionResponse VerifyTransactionResponse
-	err = json.Unmarshal(body, &transactionResponse)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
-	}
-
-	return &transactionResponse, nil
+func (c *Client) VerifyTransaction(ctx context.Context, reference string) (*VerifyTransactionResponse, error) {
+	return call[Transaction](ctx, c, "GET", pathf("/transaction/verify/%s", reference), nil)
 }
 
 // ListTransactions retrieves a list of transactions from the Paystack API.
-// It sends a GET request to the /transaction endpoint with the provided request payload.
+// It sends a GET request to the /transaction endpoint with the request encoded as query parameters.
 //
 // Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
 //   - req: A pointer to a ListTransactionsRequest struct containing the request parameters.
 //
 // Returns:
 //   - A pointer to a ListTransactionsResponse struct containing the response data.
 //   - An error if the request fails or the response cannot be decoded.
-func (c *Client) ListTransactions(req *ListTransactionsRequest) (*ListTransactionsResponse, error) {
-	url := config.BaseURL + "/transaction"
-
-	payload, err := json.Marshal(req)
+func (c *Client) ListTransactions(ctx context.Context, req *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	path, err := withQuery("/transaction", req)
 	if err != nil {
-		return nil, fmt.Errorf("error marshalling request: %v", err)
+		return nil, err
 	}
 
-	//create a new request
-	request, err := http.NewRequest("GET", url, bytes.NewBuffer(payload))
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
-	}
-
-	//set the request headers
-	request.Header.Set("Authorization", "Bearer "+c.secretKey)
-	request.Header.Set("Content-Type", "application/json")
-
-	// make the request
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
-	}
-
-	defer response.Body.Close()
-
-	//decode the response
-
-	var listTransactionsResponse ListTransactionsResponse
-	err = json.NewDecoder(response.Body).Decode(&listTransactionsResponse)
-	if err != nil {
-		return nil, fmt.Errorf("error decoding response: %v", err)
-	}
-
-	return &listTransactionsResponse, nil
+	return call[[]Transaction](ctx, c, "GET", path, nil)
 }
 
 // FetchTransaction retrieves the details of a transaction from Paystack using the provided reference.
 // It sends a GET request to the Paystack API and returns the transaction details.
 //
 // Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
 //   - reference: A string representing the transaction reference.
 //
 // Returns:
-//   - *schema.VerifyTransactionResponse: A pointer to the response structure containing transaction details.
+//   - *VerifyTransactionResponse: A pointer to the response structure containing transaction details.
 //   - error: An error object if an error occurred during the request or response parsing.
 //
 // Example:
 //
-//	transaction, err := client.FetchTransaction("transaction_reference")
+//	transaction, err := client.FetchTransaction(ctx, "transaction_reference")
 //	if err != nil {
 //	    log.Fatalf("Error fetching transaction: %v", err)
 //	}
 //	fmt.Printf("Transaction details: %+v\n", transaction)
-func (c *Client) FetchTransaction(reference string) (*VerifyTransactionResponse, error) {
-	url := fmt.Sprintf("%s/transaction/%s", config.BaseURL, reference)
+func (c *Client) FetchTransaction(ctx context.Context, reference string) (*VerifyTransactionResponse, error) {
+	return call[Transaction](ctx, c, "GET", pathf("/transaction/%s", reference), nil)
+}
 
-	//create a new request
-	request, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+// IterateTransactions returns an iterator over every transaction matching
+// req, walking the pages of ListTransactions lazily. Iteration starts at
+// req.Page (or the first page) and stops early if ctx is cancelled.
+func (c *Client) IterateTransactions(ctx context.Context, req *ListTransactionsRequest) *Iter[Transaction] {
+	params := ListTransactionsRequest{}
+	if req != nil {
+		params = *req
 	}
 
-	//set the request headers
-	request.Header.Set("Authorization", "Bearer "+c.secretKey)
-	request.Header.Set("Content-Type", "application/json")
+	return newIter(ctx, params.Page, func(ctx context.Context, page int) ([]Transaction, *Meta, error) {
+		params.Page = page
+		response, err := c.ListTransactions(ctx, &params)
+		if err != nil {
+			return nil, nil, err
+		}
 
-	// make the request
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
-	}
-	defer response.Body.Close()
+		return response.Data, response.Meta, nil
+	})
+}
 
-	//decode the response
-	body, err := ioutil.ReadAll(response.Body)
+// ViewTransactionTimeline retrieves the checkout timeline of a transaction.
+// It sends a GET request to the /transaction/timeline/:id_or_reference endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - idOrReference: The ID or reference of the transaction.
+//
+// Returns:
+//   - A pointer to a TransactionTimelineResponse struct containing the timeline.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ViewTransactionTimeline(ctx context.Context, idOrReference string) (*TransactionTimelineResponse, error) {
+	return call[TransactionLog](ctx, c, "GET", pathf("/transaction/timeline/%s", idOrReference), nil)
+}
+
+// TransactionTotals retrieves the total amount received on the integration,
+// broken down per currency.
+// It sends a GET request to the /transaction/totals endpoint with the request encoded as query parameters.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a TransactionTotalsRequest struct containing the request parameters, or nil.
+//
+// Returns:
+//   - A pointer to a TransactionTotalsResponse struct containing the totals.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) TransactionTotals(ctx context.Context, req *TransactionTotalsRequest) (*TransactionTotalsResponse, error) {
+	path, err := withQuery("/transaction/totals", req)
 	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %v", err)
+		return nil, err
 	}
 
-	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error: %s", body)
-	}
+	return call[TransactionTotals](ctx, c, "GET", path, nil)
+}
 
-	// parse the response
-	var transactionResponse VerifyTransactionResponse
-	err = json.Unmarshal(body, &transactionResponse)
+// ExportTransactions requests a CSV export of the transactions matching req.
+// It sends a GET request to the /transaction/export endpoint with the request encoded as query parameters.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to an ExportTransactionsRequest struct containing the request parameters, or nil.
+//
+// Returns:
+//   - A pointer to an ExportTransactionsResponse struct containing the download path of the export.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ExportTransactions(ctx context.Context, req *ExportTransactionsRequest) (*ExportTransactionsResponse, error) {
+	path, err := withQuery("/transaction/export", req)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing response: %v", err)
+		return nil, err
 	}
 
-	return &transactionResponse, nil
+	return call[TransactionExport](ctx, c, "GET", path, nil)
 }
@@ -1,56 +1,465 @@
 package paystack
 
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
 // InitializeTransactionRequest represents the body parameters for the InitializeTransaction API.
 type InitializeTransactionRequest struct {
-	Reference   string                 `json:"reference"`
-	Amount      int                    `json:"amount"`
+	Reference string `json:"reference"`
+	// Amount is sent in minor units. Its currency is sent as Currency
+	// unless Currency is set explicitly.
+	Amount      Money                  `json:"amount"`
+	Currency    Currency               `json:"currency,omitempty"`
 	Email       string                 `json:"email"`
 	CallbackURL string                 `json:"callback_url,omitempty"`
 	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	// Subaccount is the code of a subaccount that should receive a share of
+	// the payment. TransactionCharge overrides the flat amount the
+	// integration keeps and Bearer decides who pays the fees.
+	Subaccount        string       `json:"subaccount,omitempty"`
+	TransactionCharge *Money       `json:"transaction_charge,omitempty"`
+	Bearer            ChargeBearer `json:"bearer,omitempty"`
+	// SplitCode is the code of a split created with CreateSplit; Split may
+	// instead carry a dynamic split for this transaction only.
+	SplitCode string        `json:"split_code,omitempty"`
+	Split     *DynamicSplit `json:"split,omitempty"`
+}
+
+// MarshalJSON fills in the currency from Amount when Currency is not set.
+func (r InitializeTransactionRequest) MarshalJSON() ([]byte, error) {
+	type request InitializeTransactionRequest
+	if r.Currency == "" {
+		r.Currency = r.Amount.Currency
+	}
+
+	return json.Marshal(request(r))
+}
+
+// Validate checks the request before it is sent.
+func (r *InitializeTransactionRequest) Validate() error {
+	v := validation{}
+	v.email(r.Email, "email")
+	v.positive(r.Amount.Amount, "amount")
+	v.currency(r.Currency, "currency")
+	v.currency(r.Amount.Currency, "amount")
+	v.check(r.Currency == "" || r.Amount.Currency == "" || r.Currency == r.Amount.Currency,
+		"currency", "does not match the currency of amount")
+	v.reference(r.Reference, "reference")
+	v.transactionCharge(r.TransactionCharge, r.Amount)
+	v.chargeBearer(r.Bearer, r.Subaccount)
+	v.check(r.SplitCode == "" || r.Split == nil, "split", "cannot be set together with split_code")
+	if r.Split != nil {
+		v.nested("split", r.Split.Validate())
+	}
+
+	return v.err()
+}
+
+// InitializedTransaction represents the checkout details returned by the InitializeTransaction API.
+type InitializedTransaction struct {
+	AuthorizationURL string `json:"authorization_url"`
+	AccessCode       string `json:"access_code"`
+	Reference        string `json:"reference"`
 }
 
 // TransactionResponse represents the response body for the InitializeTransaction API.
-type TransactionResponse struct {
-	Status  bool   `json:"status"`
-	Message string `json:"message"`
-	Data    struct {
-		AuthorizationURL string `json:"authorization_url"`
-		AccessCode       string `json:"access_code"`
-		Reference        string `json:"reference"`
-	} `json:"data"`
+type TransactionResponse = Response[InitializedTransaction]
+
+// TransactionStatus is the status of a transaction.
+type TransactionStatus string
+
+// Transaction statuses returned by the Paystack API.
+const (
+	TransactionStatusSuccess    TransactionStatus = "success"
+	TransactionStatusFailed     TransactionStatus = "failed"
+	TransactionStatusAbandoned  TransactionStatus = "abandoned"
+	TransactionStatusOngoing    TransactionStatus = "ongoing"
+	TransactionStatusPending    TransactionStatus = "pending"
+	TransactionStatusProcessing TransactionStatus = "processing"
+	TransactionStatusQueued     TransactionStatus = "queued"
+	TransactionStatusReversed   TransactionStatus = "reversed"
+	// TransactionStatusSendOTP is returned by ChargeAuthorization when the
+	// issuer requires the customer to confirm the charge with an OTP.
+	TransactionStatusSendOTP TransactionStatus = "send_otp"
+)
+
+// Transaction represents a transaction object returned by the Paystack API.
+// It is shared by VerifyTransaction, FetchTransaction and ListTransactions.
+type Transaction struct {
+	ID              int               `json:"id"`
+	Domain          string            `json:"domain"`
+	Status          TransactionStatus `json:"status"`
+	Reference       string            `json:"reference"`
+	ReceiptNumber   string            `json:"receipt_number"`
+	Amount          Money             `json:"amount"`
+	RequestedAmount Money             `json:"requested_amount"`
+	Message         string            `json:"message"`
+	GatewayResponse string            `json:"gateway_response"`
+	Channel         string            `json:"channel"`
+	Currency        Currency          `json:"currency"`
+	IPAddress       string            `json:"ip_address"`
+	// Metadata is whatever was attached when the transaction was initialized;
+	// Paystack returns an empty string when nothing was.
+	Metadata      interface{}     `json:"metadata"`
+	Log           *TransactionLog `json:"log"`
+	Fees          Money           `json:"fees"`
+	FeesSplit     *FeesSplit      `json:"fees_split"`
+	Authorization Authorization   `json:"authorization"`
+	Customer      Customer        `json:"customer"`
+	OrderID       interface{}     `json:"order_id"`
+	// DisplayText is the instruction to show the customer when a charge
+	// needs further action, e.g. entering an OTP.
+	DisplayText     string `json:"display_text,omitempty"`
+	PaidAt          string `json:"paid_at"`
+	CreatedAt       string `json:"created_at"`
+	TransactionDate string `json:"transaction_date"`
+}
+
+// UnmarshalJSON decodes a transaction and sets the currency of its amounts.
+func (t *Transaction) UnmarshalJSON(data []byte) error {
+	type transaction Transaction
+	if err := json.Unmarshal(data, (*transaction)(t)); err != nil {
+		return err
+	}
+
+	t.Amount.Currency = t.Currency
+	t.RequestedAmount.Currency = t.Currency
+	t.Fees.Currency = t.Currency
+	if t.FeesSplit != nil {
+		t.FeesSplit.Paystack.Currency = t.Currency
+		t.FeesSplit.Integration.Currency = t.Currency
+		t.FeesSplit.Subaccount.Currency = t.Currency
+	}
+	return nil
+}
+
+// IsSuccessful reports whether the transaction completed successfully.
+func (t *Transaction) IsSuccessful() bool {
+	return t.Status == TransactionStatusSuccess
+}
+
+// RequiresOTP reports whether the customer must confirm the charge with an OTP.
+func (t *Transaction) RequiresOTP() bool {
+	return t.Status == TransactionStatusSendOTP
 }
 
-// VerifyTransactionResponse represents the response body for the VerifyTransaction API.
-type VerifyTransactionResponse struct {
-	Status  bool   `json:"status"`
+// IsPending reports whether the outcome of the transaction is not known yet
+// and it should be verified again later.
+func (t *Transaction) IsPending() bool {
+	switch t.Status {
+	case TransactionStatusPending, TransactionStatusOngoing, TransactionStatusProcessing, TransactionStatusQueued:
+		return true
+	}
+
+	return false
+}
+
+// Authorization represents the reusable payment authorization attached to a
+// successful transaction. AuthorizationCode can be used to charge the same
+// instrument again when Reusable is true.
+type Authorization struct {
+	AuthorizationCode         string `json:"authorization_code"`
+	Bin                       string `json:"bin"`
+	Last4                     string `json:"last4"`
+	ExpMonth                  string `json:"exp_month"`
+	ExpYear                   string `json:"exp_year"`
+	Channel                   string `json:"channel"`
+	CardType                  string `json:"card_type"`
+	Bank                      string `json:"bank"`
+	CountryCode               string `json:"country_code"`
+	Brand                     string `json:"brand"`
+	Reusable                  bool   `json:"reusable"`
+	Signature                 string `json:"signature"`
+	AccountName               string `json:"account_name"`
+	ReceiverBankAccountNumber string `json:"receiver_bank_account_number"`
+	ReceiverBank              string `json:"receiver_bank"`
+}
+
+// TransactionLog represents the checkout session log of a transaction.
+type TransactionLog struct {
+	StartTime int                  `json:"start_time"`
+	TimeSpent int                  `json:"time_spent"`
+	Attempts  int                  `json:"attempts"`
+	Errors    int                  `json:"errors"`
+	Success   bool                 `json:"success"`
+	Mobile    bool                 `json:"mobile"`
+	Input     []interface{}        `json:"input"`
+	History   []TransactionHistory `json:"history"`
+}
+
+// TransactionHistory represents a single step in a transaction log or timeline.
+type TransactionHistory struct {
+	Type    string `json:"type"`
 	Message string `json:"message"`
-	Data    struct {
-		Amount            int    `json:"amount"`
-		TransactionDate   string `json:"transaction_date"`
-		TransactionStatus string `json:"status"`
-		Reference         string `json:"reference"`
-	} `json:"data"`
+	Time    int    `json:"time"`
+}
+
+// FeesSplit represents how the fees of a split transaction were shared.
+type FeesSplit struct {
+	Paystack    Money `json:"paystack"`
+	Integration Money `json:"integration"`
+	Subaccount  Money `json:"subaccount"`
+	Params      struct {
+		Bearer            string `json:"bearer"`
+		TransactionCharge string `json:"transaction_charge"`
+		PercentageCharge  string `json:"percentage_charge"`
+	} `json:"params"`
+}
+
+// VerifyTransactionResponse represents the response body for the VerifyTransaction and FetchTransaction APIs.
+type VerifyTransactionResponse = Response[Transaction]
+
+// ChargeAuthorizationRequest represents the body parameters for the ChargeAuthorization API.
+type ChargeAuthorizationRequest struct {
+	AuthorizationCode string `json:"authorization_code"`
+	Email             string `json:"email"`
+	// Amount is sent in minor units. Its currency is sent as Currency
+	// unless Currency is set explicitly.
+	Amount    Money                  `json:"amount"`
+	Currency  Currency               `json:"currency,omitempty"`
+	Reference string                 `json:"reference,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	// Channels restricts the channels the charge may use, e.g. "card" or "bank".
+	Channels []string `json:"channels,omitempty"`
+	// Subaccount is the code of the subaccount that should receive a share
+	// of the payment.
+	Subaccount        string       `json:"subaccount,omitempty"`
+	TransactionCharge *Money       `json:"transaction_charge,omitempty"`
+	Bearer            ChargeBearer `json:"bearer,omitempty"`
+	// SplitCode is the code of a split to apply; Split may instead carry a
+	// dynamic split definition.
+	SplitCode string        `json:"split_code,omitempty"`
+	Split     *DynamicSplit `json:"split,omitempty"`
+	// Queue asks Paystack to queue the charge when processing many
+	// recurring charges at once.
+	Queue bool `json:"queue,omitempty"`
+}
+
+// MarshalJSON fills in the currency from Amount when Currency is not set.
+func (r ChargeAuthorizationRequest) MarshalJSON() ([]byte, error) {
+	type request ChargeAuthorizationRequest
+	if r.Currency == "" {
+		r.Currency = r.Amount.Currency
+	}
+
+	return json.Marshal(request(r))
+}
+
+// Validate checks the request before it is sent.
+func (r *ChargeAuthorizationRequest) Validate() error {
+	v := validation{}
+	v.required(r.AuthorizationCode, "authorization_code")
+	v.email(r.Email, "email")
+	v.positive(r.Amount.Amount, "amount")
+	v.currency(r.Currency, "currency")
+	v.currency(r.Amount.Currency, "amount")
+	v.check(r.Currency == "" || r.Amount.Currency == "" || r.Currency == r.Amount.Currency,
+		"currency", "does not match the currency of amount")
+	v.reference(r.Reference, "reference")
+	v.transactionCharge(r.TransactionCharge, r.Amount)
+	v.chargeBearer(r.Bearer, r.Subaccount)
+	v.check(r.SplitCode == "" || r.Split == nil, "split", "cannot be set together with split_code")
+	if r.Split != nil {
+		v.nested("split", r.Split.Validate())
+	}
+
+	return v.err()
+}
+
+// ChargeAuthorizationResponse represents the response body for the ChargeAuthorization API.
+type ChargeAuthorizationResponse = Response[Transaction]
+
+// PartialDebitRequest represents the body parameters for the PartialDebit API.
+type PartialDebitRequest struct {
+	AuthorizationCode string `json:"authorization_code"`
+	// Currency is required by Paystack; it is taken from Amount when not set.
+	Currency Currency `json:"currency"`
+	Amount   Money    `json:"amount"`
+	Email    string   `json:"email"`
+	// Reference identifies the debit.
+	Reference string `json:"reference,omitempty"`
+	// AtLeast is the minimum amount that must be debited for the charge to
+	// go through. When zero, Paystack debits whatever balance is available
+	// up to Amount.
+	AtLeast Money `json:"at_least"`
+}
+
+// MarshalJSON fills in the currency from Amount when Currency is not set
+// and sends AtLeast as a string, as Paystack expects.
+func (r PartialDebitRequest) MarshalJSON() ([]byte, error) {
+	type request PartialDebitRequest
+	if r.Currency == "" {
+		r.Currency = r.Amount.Currency
+	}
+
+	body := struct {
+		request
+		AtLeast string `json:"at_least,omitempty"`
+	}{request: request(r)}
+	if !r.AtLeast.IsZero() {
+		body.AtLeast = strconv.FormatInt(r.AtLeast.Amount, 10)
+	}
+
+	return json.Marshal(body)
 }
 
-// ListTransactionsRequest represents the body parameters for the ListTransactions API.
+// Validate checks the request before it is sent.
+func (r *PartialDebitRequest) Validate() error {
+	v := validation{}
+	v.required(r.AuthorizationCode, "authorization_code")
+	v.check(r.Currency != "" || r.Amount.Currency != "", "currency", "is required")
+	v.currency(r.Currency, "currency")
+	v.currency(r.Amount.Currency, "amount")
+	v.check(r.Currency == "" || r.Amount.Currency == "" || r.Currency == r.Amount.Currency,
+		"currency", "does not match the currency of amount")
+	v.positive(r.Amount.Amount, "amount")
+	v.email(r.Email, "email")
+	v.reference(r.Reference, "reference")
+	v.check(r.AtLeast.Amount >= 0 && r.AtLeast.Amount <= r.Amount.Amount, "at_least", "must be between zero and amount")
+	v.check(r.AtLeast.Currency == "" || r.AtLeast.Currency == r.Amount.Currency,
+		"at_least", "does not match the currency of amount")
+
+	return v.err()
+}
+
+// PartialDebitResponse represents the response body for the PartialDebit API.
+type PartialDebitResponse = Response[Transaction]
+
+// ListTransactionsRequest represents the query parameters for the ListTransactions API.
 type ListTransactionsRequest struct {
-	PerPage int    `json:"perPage,omitempty"`
-	Page    int    `json:"page,omitempty"`
-	From    string `json:"from,omitempty"`
-	To      string `json:"to,omitempty"`
+	PerPage int `url:"perPage,omitempty"`
+	Page    int `url:"page,omitempty"`
+	// Customer filters by the ID of the customer who paid.
+	Customer int `url:"customer,omitempty"`
+	// TerminalID filters by the ID of the terminal that processed the transaction.
+	TerminalID string `url:"terminalid,omitempty"`
+	// Status filters by transaction status: success, failed or abandoned.
+	Status   TransactionStatus `url:"status,omitempty"`
+	Amount   int               `url:"amount,omitempty"`
+	Currency Currency          `url:"currency,omitempty"`
+	From     time.Time         `url:"from,omitempty"`
+	To       time.Time         `url:"to,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ListTransactionsRequest) Validate() error {
+	v := validation{}
+	v.paging(r.PerPage, r.Page)
+	v.dateRange(r.From, r.To)
+	v.currency(r.Currency, "currency")
+
+	return v.err()
 }
 
 // ListTransactionsResponse represents the response body for the ListTransactions API.
-type ListTransactionsResponse struct {
-	Status  bool   `json:"status"`
-	Message string `json:"message"`
-	Data    []struct {
-		ID              int    `json:"id"`
-		TransactionDate string `json:"transaction_date"`
-		Amount          int    `json:"amount"`
-		Currency        string `json:"currency"`
-		Channel         string `json:"channel"`
-		Reference       string `json:"reference"`
-		Status          string `json:"status"`
-	} `json:"data"`
+type ListTransactionsResponse = Response[[]Transaction]
+
+// TransactionTimelineResponse represents the response body for the ViewTransactionTimeline API.
+type TransactionTimelineResponse = Response[TransactionLog]
+
+// TransactionTotalsRequest represents the query parameters for the TransactionTotals API.
+type TransactionTotalsRequest struct {
+	PerPage int       `url:"perPage,omitempty"`
+	Page    int       `url:"page,omitempty"`
+	From    time.Time `url:"from,omitempty"`
+	To      time.Time `url:"to,omitempty"`
 }
+
+// Validate checks the request before it is sent.
+func (r *TransactionTotalsRequest) Validate() error {
+	v := validation{}
+	v.paging(r.PerPage, r.Page)
+	v.dateRange(r.From, r.To)
+
+	return v.err()
+}
+
+// CurrencyAmount represents an amount for a single currency.
+type CurrencyAmount struct {
+	Currency Currency `json:"currency"`
+	Amount   Money    `json:"amount"`
+}
+
+// UnmarshalJSON decodes an amount and sets its currency.
+func (a *CurrencyAmount) UnmarshalJSON(data []byte) error {
+	type currencyAmount CurrencyAmount
+	if err := json.Unmarshal(data, (*currencyAmount)(a)); err != nil {
+		return err
+	}
+
+	a.Amount.Currency = a.Currency
+	return nil
+}
+
+// TransactionTotals represents the totals returned by the TransactionTotals API.
+type TransactionTotals struct {
+	TotalTransactions int `json:"total_transactions"`
+	// TotalVolume and PendingTransfers only carry a currency when the
+	// breakdown by currency has a single entry; otherwise they add up
+	// amounts in different currencies and should be read per currency.
+	TotalVolume                Money            `json:"total_volume"`
+	TotalVolumeByCurrency      []CurrencyAmount `json:"total_volume_by_currency"`
+	PendingTransfers           Money            `json:"pending_transfers"`
+	PendingTransfersByCurrency []CurrencyAmount `json:"pending_transfers_by_currency"`
+}
+
+// UnmarshalJSON decodes the totals and sets the currency of the overall
+// amounts when only one currency is involved.
+func (t *TransactionTotals) UnmarshalJSON(data []byte) error {
+	type totals TransactionTotals
+	if err := json.Unmarshal(data, (*totals)(t)); err != nil {
+		return err
+	}
+
+	if len(t.TotalVolumeByCurrency) == 1 {
+		t.TotalVolume.Currency = t.TotalVolumeByCurrency[0].Currency
+	}
+	if len(t.PendingTransfersByCurrency) == 1 {
+		t.PendingTransfers.Currency = t.PendingTransfersByCurrency[0].Currency
+	}
+
+	return nil
+}
+
+// TransactionTotalsResponse represents the response body for the TransactionTotals API.
+type TransactionTotalsResponse = Response[TransactionTotals]
+
+// ExportTransactionsRequest represents the query parameters for the ExportTransactions API.
+type ExportTransactionsRequest struct {
+	PerPage  int               `url:"perPage,omitempty"`
+	Page     int               `url:"page,omitempty"`
+	From     time.Time         `url:"from,omitempty"`
+	To       time.Time         `url:"to,omitempty"`
+	Customer int               `url:"customer,omitempty"`
+	Status   TransactionStatus `url:"status,omitempty"`
+	Currency Currency          `url:"currency,omitempty"`
+	Amount   int               `url:"amount,omitempty"`
+	// Settled restricts the export to settled (true) or unsettled (false)
+	// transactions; nil exports both.
+	Settled *bool `url:"settled,omitempty"`
+	// Settlement restricts the export to the transactions of one settlement.
+	Settlement  int `url:"settlement,omitempty"`
+	PaymentPage int `url:"payment_page,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ExportTransactionsRequest) Validate() error {
+	v := validation{}
+	v.paging(r.PerPage, r.Page)
+	v.dateRange(r.From, r.To)
+	v.currency(r.Currency, "currency")
+
+	return v.err()
+}
+
+// TransactionExport represents the download details returned by the ExportTransactions API.
+type TransactionExport struct {
+	Path      string `json:"path"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+// ExportTransactionsResponse represents the response body for the ExportTransactions API.
+type ExportTransactionsResponse = Response[TransactionExport]
@@ -0,0 +1,116 @@
+package paystack
+
+import (
+	"context"
+)
+
+// InitiateTransfer sends money from the integration's balance to a transfer recipient.
+// It sends a POST request to the /transfer endpoint with the provided request payload.
+// When transfer OTPs are enabled the returned transfer has status otp and
+// must be completed with FinalizeTransfer.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to an InitiateTransferRequest struct containing the transfer details.
+//
+// Returns:
+//   - A pointer to a TransferResponse struct containing the transfer.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) InitiateTransfer(ctx context.Context, req *InitiateTransferRequest) (*TransferResponse, error) {
+	return call[Transfer](ctx, c, "POST", "/transfer", req)
+}
+
+// FinalizeTransfer completes a transfer that is waiting for an OTP.
+// It sends a POST request to the /transfer/finalize_transfer endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a FinalizeTransferRequest struct containing the transfer code and OTP.
+//
+// Returns:
+//   - A pointer to a TransferResponse struct containing the transfer.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) FinalizeTransfer(ctx context.Context, req *FinalizeTransferRequest) (*TransferResponse, error) {
+	return call[Transfer](ctx, c, "POST", "/transfer/finalize_transfer", req)
+}
+
+// InitiateBulkTransfer queues several transfers at once.
+// It sends a POST request to the /transfer/bulk endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to an InitiateBulkTransferRequest struct containing the transfers.
+//
+// Returns:
+//   - A pointer to a BulkTransferResponse struct containing the queued transfers.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) InitiateBulkTransfer(ctx context.Context, req *InitiateBulkTransferRequest) (*BulkTransferResponse, error) {
+	return call[[]BulkTransfer](ctx, c, "POST", "/transfer/bulk", req)
+}
+
+// ListTransfers retrieves the transfers made on the integration.
+// It sends a GET request to the /transfer endpoint with the request encoded as query parameters.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a ListTransfersRequest struct containing the request parameters, or nil.
+//
+// Returns:
+//   - A pointer to a ListTransfersResponse struct containing the transfers.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ListTransfers(ctx context.Context, req *ListTransfersRequest) (*ListTransfersResponse, error) {
+	path, err := withQuery("/transfer", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return call[[]Transfer](ctx, c, "GET", path, nil)
+}
+
+// IterateTransfers returns an iterator over every transfer matching req,
+// walking the pages of ListTransfers lazily. Iteration starts at req.Page
+// (or the first page) and stops early if ctx is cancelled.
+func (c *Client) IterateTransfers(ctx context.Context, req *ListTransfersRequest) *Iter[Transfer] {
+	params := ListTransfersRequest{}
+	if req != nil {
+		params = *req
+	}
+
+	return newIter(ctx, params.Page, func(ctx context.Context, page int) ([]Transfer, *Meta, error) {
+		params.Page = page
+		response, err := c.ListTransfers(ctx, &params)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, response.Meta, nil
+	})
+}
+
+// FetchTransfer retrieves a transfer by its ID or code.
+// It sends a GET request to the /transfer/:id_or_code endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - idOrCode: The ID or transfer code of the transfer.
+//
+// Returns:
+//   - A pointer to a TransferResponse struct containing the transfer.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) FetchTransfer(ctx context.Context, idOrCode string) (*TransferResponse, error) {
+	return call[Transfer](ctx, c, "GET", pathf("/transfer/%s", idOrCode), nil)
+}
+
+// VerifyTransfer retrieves the status of a transfer by its reference.
+// It sends a GET request to the /transfer/verify/:reference endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - reference: The reference of the transfer.
+//
+// Returns:
+//   - A pointer to a TransferResponse struct containing the transfer.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) VerifyTransfer(ctx context.Context, reference string) (*TransferResponse, error) {
+	return call[Transfer](ctx, c, "GET", pathf("/transfer/verify/%s", reference), nil)
+}
@@ -0,0 +1,113 @@
+package paystack
+
+import (
+	"context"
+)
+
+// CheckBalance retrieves the available balance of the integration in each currency.
+// It sends a GET request to the /balance endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//
+// Returns:
+//   - A pointer to a BalanceResponse struct containing one balance per currency.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) CheckBalance(ctx context.Context) (*BalanceResponse, error) {
+	return call[[]Balance](ctx, c, "GET", "/balance", nil)
+}
+
+// FetchBalanceLedger retrieves the movements on the integration's balance.
+// It sends a GET request to the /balance/ledger endpoint with the request encoded as query parameters.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a BalanceLedgerRequest struct containing the request parameters, or nil.
+//
+// Returns:
+//   - A pointer to a BalanceLedgerResponse struct containing the ledger entries.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) FetchBalanceLedger(ctx context.Context, req *BalanceLedgerRequest) (*BalanceLedgerResponse, error) {
+	path, err := withQuery("/balance/ledger", req)
+	if err != nil {
+		return nil, err
+	}
+
+	return call[[]BalanceLedgerEntry](ctx, c, "GET", path, nil)
+}
+
+// IterateBalanceLedger returns an iterator over every ledger entry matching
+// req, walking the pages of FetchBalanceLedger lazily. Iteration starts at
+// req.Page (or the first page) and stops early if ctx is cancelled.
+func (c *Client) IterateBalanceLedger(ctx context.Context, req *BalanceLedgerRequest) *Iter[BalanceLedgerEntry] {
+	params := BalanceLedgerRequest{}
+	if req != nil {
+		params = *req
+	}
+
+	return newIter(ctx, params.Page, func(ctx context.Context, page int) ([]BalanceLedgerEntry, *Meta, error) {
+		params.Page = page
+		response, err := c.FetchBalanceLedger(ctx, &params)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return response.Data, response.Meta, nil
+	})
+}
+
+// ResendTransferOTP sends the OTP of a transfer again.
+// It sends a POST request to the /transfer/resend_otp endpoint with the provided request payload.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - req: A pointer to a ResendTransferOTPRequest struct containing the transfer code and reason.
+//
+// Returns:
+//   - A pointer to a MessageResponse struct acknowledging the OTP.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) ResendTransferOTP(ctx context.Context, req *ResendTransferOTPRequest) (*MessageResponse, error) {
+	return call[interface{}](ctx, c, "POST", "/transfer/resend_otp", req)
+}
+
+// DisableTransferOTP starts turning off OTPs for transfers. Paystack sends
+// an OTP to the business phone number which must be passed to FinalizeDisableOTP.
+// It sends a POST request to the /transfer/disable_otp endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//
+// Returns:
+//   - A pointer to a MessageResponse struct acknowledging the request.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) DisableTransferOTP(ctx context.Context) (*MessageResponse, error) {
+	return call[interface{}](ctx, c, "POST", "/transfer/disable_otp", nil)
+}
+
+// FinalizeDisableOTP completes turning off OTPs for transfers.
+// It sends a POST request to the /transfer/disable_otp_finalize endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//   - otp: The OTP sent by DisableTransferOTP.
+//
+// Returns:
+//   - A pointer to a MessageResponse struct acknowledging the change.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) FinalizeDisableOTP(ctx context.Context, otp string) (*MessageResponse, error) {
+	body := &finalizeDisableOTPRequest{OTP: otp}
+	return call[interface{}](ctx, c, "POST", "/transfer/disable_otp_finalize", body)
+}
+
+// EnableTransferOTP turns OTPs for transfers back on.
+// It sends a POST request to the /transfer/enable_otp endpoint.
+//
+// Parameters:
+//   - ctx: The context governing cancellation and deadlines of the request.
+//
+// Returns:
+//   - A pointer to a MessageResponse struct acknowledging the change.
+//   - An error if the request fails or the response cannot be parsed.
+func (c *Client) EnableTransferOTP(ctx context.Context) (*MessageResponse, error) {
+	return call[interface{}](ctx, c, "POST", "/transfer/enable_otp", nil)
+}
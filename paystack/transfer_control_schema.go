@@ -0,0 +1,117 @@
+package paystack
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Balance represents the available balance of the integration in a single currency.
+type Balance struct {
+	Currency Currency `json:"currency"`
+	// Balance is in minor units of Currency.
+	Balance Money `json:"balance"`
+}
+
+// UnmarshalJSON decodes a balance and sets the currency of its amount.
+func (b *Balance) UnmarshalJSON(data []byte) error {
+	type balance Balance
+	if err := json.Unmarshal(data, (*balance)(b)); err != nil {
+		return err
+	}
+
+	b.Balance.Currency = b.Currency
+	return nil
+}
+
+// BalanceResponse represents the response body for the CheckBalance API.
+type BalanceResponse = Response[[]Balance]
+
+// BalanceLedgerEntry represents a single movement on the integration's balance.
+type BalanceLedgerEntry struct {
+	ID          int      `json:"id"`
+	Integration int      `json:"integration"`
+	Domain      string   `json:"domain"`
+	Currency    Currency `json:"currency"`
+	// Balance is the balance after the movement and Difference the signed
+	// amount it moved by, both in minor units of Currency.
+	Balance    Money  `json:"balance"`
+	Difference Money  `json:"difference"`
+	Reason     string `json:"reason"`
+	// ModelResponsible names the kind of object, e.g. "Transfer", that
+	// caused the movement and ModelRow its ID.
+	ModelResponsible string `json:"model_responsible"`
+	ModelRow         int    `json:"model_row"`
+	CreatedAt        string `json:"createdAt"`
+	UpdatedAt        string `json:"updatedAt"`
+}
+
+// UnmarshalJSON decodes a ledger entry and sets the currency of its amounts.
+func (e *BalanceLedgerEntry) UnmarshalJSON(data []byte) error {
+	type entry BalanceLedgerEntry
+	if err := json.Unmarshal(data, (*entry)(e)); err != nil {
+		return err
+	}
+
+	e.Balance.Currency = e.Currency
+	e.Difference.Currency = e.Currency
+	return nil
+}
+
+// BalanceLedgerRequest represents the query parameters for the FetchBalanceLedger API.
+type BalanceLedgerRequest struct {
+	PerPage int       `url:"perPage,omitempty"`
+	Page    int       `url:"page,omitempty"`
+	From    time.Time `url:"from,omitempty"`
+	To      time.Time `url:"to,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *BalanceLedgerRequest) Validate() error {
+	v := validation{}
+	v.paging(r.PerPage, r.Page)
+	v.dateRange(r.From, r.To)
+
+	return v.err()
+}
+
+// BalanceLedgerResponse represents the response body for the FetchBalanceLedger API.
+type BalanceLedgerResponse = Response[[]BalanceLedgerEntry]
+
+// ResendOTPReason is why a transfer OTP is being sent again.
+type ResendOTPReason string
+
+// Reasons accepted by the ResendTransferOTP API.
+const (
+	ResendOTPReasonResendOTP ResendOTPReason = "resend_otp"
+	ResendOTPReasonTransfer  ResendOTPReason = "transfer"
+)
+
+// ResendTransferOTPRequest represents the body parameters for the ResendTransferOTP API.
+type ResendTransferOTPRequest struct {
+	TransferCode string          `json:"transfer_code"`
+	Reason       ResendOTPReason `json:"reason"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ResendTransferOTPRequest) Validate() error {
+	v := validation{}
+	v.required(r.TransferCode, "transfer_code")
+	v.check(r.Reason == ResendOTPReasonResendOTP || r.Reason == ResendOTPReasonTransfer, "reason",
+		fmt.Sprintf("unsupported reason %q", r.Reason))
+
+	return v.err()
+}
+
+// finalizeDisableOTPRequest represents the body parameters for the FinalizeDisableOTP API.
+type finalizeDisableOTPRequest struct {
+	OTP string `json:"otp"`
+}
+
+// Validate checks the request before it is sent.
+func (r *finalizeDisableOTPRequest) Validate() error {
+	v := validation{}
+	v.required(r.OTP, "otp")
+
+	return v.err()
+}
@@ -0,0 +1,257 @@
+package paystack
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TransferStatus is the state of a transfer.
+type TransferStatus string
+
+// Transfer statuses returned by Paystack.
+const (
+	TransferStatusPending   TransferStatus = "pending"
+	TransferStatusSuccess   TransferStatus = "success"
+	TransferStatusFailed    TransferStatus = "failed"
+	TransferStatusReversed  TransferStatus = "reversed"
+	TransferStatusOTP       TransferStatus = "otp"
+	TransferStatusAbandoned TransferStatus = "abandoned"
+	TransferStatusBlocked   TransferStatus = "blocked"
+	TransferStatusRejected  TransferStatus = "rejected"
+	TransferStatusReceived  TransferStatus = "received"
+)
+
+// Transfer represents a payout from the integration's balance to a transfer recipient.
+type Transfer struct {
+	ID          int    `json:"id"`
+	Integration int    `json:"integration"`
+	Domain      string `json:"domain"`
+	// Amount is in minor units of Currency.
+	Amount       Money          `json:"amount"`
+	Currency     Currency       `json:"currency"`
+	Source       string         `json:"source"`
+	Reason       string         `json:"reason"`
+	Reference    string         `json:"reference"`
+	Status       TransferStatus `json:"status"`
+	TransferCode string         `json:"transfer_code"`
+	// Recipient only carries its ID when returned by the InitiateTransfer
+	// and FinalizeTransfer APIs.
+	Recipient     TransferRecipient `json:"recipient"`
+	Failures      interface{}       `json:"failures"`
+	TitanCode     string            `json:"titan_code"`
+	TransferredAt string            `json:"transferred_at"`
+	CreatedAt     string            `json:"createdAt"`
+	UpdatedAt     string            `json:"updatedAt"`
+}
+
+// UnmarshalJSON decodes a transfer whose recipient may be either an object
+// or a bare ID, and sets the currency of its amount.
+func (t *Transfer) UnmarshalJSON(data []byte) error {
+	type transfer Transfer
+	aux := struct {
+		*transfer
+		Recipient json.RawMessage `json:"recipient"`
+	}{transfer: (*transfer)(t)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if err := decodeObjectOrID(aux.Recipient, &t.Recipient, &t.Recipient.ID); err != nil {
+		return err
+	}
+
+	t.Amount.Currency = t.Currency
+	return nil
+}
+
+// RequiresOTP reports whether the transfer must be finalized with FinalizeTransfer.
+func (t *Transfer) RequiresOTP() bool {
+	return t.Status == TransferStatusOTP
+}
+
+// IsSuccessful reports whether the transfer reached the recipient.
+func (t *Transfer) IsSuccessful() bool {
+	return t.Status == TransferStatusSuccess
+}
+
+// InitiateTransferRequest represents the body parameters for the InitiateTransfer API.
+type InitiateTransferRequest struct {
+	// Source is where the money comes from; it defaults to "balance".
+	Source string `json:"source"`
+	// Amount is sent in minor units. Its currency is sent as Currency
+	// unless Currency is set explicitly.
+	Amount Money `json:"amount"`
+	// Recipient is the code of the transfer recipient.
+	Recipient string   `json:"recipient"`
+	Reason    string   `json:"reason,omitempty"`
+	Currency  Currency `json:"currency,omitempty"`
+	// Reference identifies the transfer and guards against paying it twice.
+	Reference string `json:"reference,omitempty"`
+}
+
+// MarshalJSON fills in the source and the currency from Amount when they are not set.
+func (r InitiateTransferRequest) MarshalJSON() ([]byte, error) {
+	type request InitiateTransferRequest
+	if r.Source == "" {
+		r.Source = "balance"
+	}
+	if r.Currency == "" {
+		r.Currency = r.Amount.Currency
+	}
+
+	return json.Marshal(request(r))
+}
+
+// Validate checks the request before it is sent.
+func (r *InitiateTransferRequest) Validate() error {
+	v := validation{}
+	v.positive(r.Amount.Amount, "amount")
+	v.currency(r.Amount.Currency, "amount")
+	v.currency(r.Currency, "currency")
+	v.check(r.Currency == "" || r.Amount.Currency == "" || r.Currency == r.Amount.Currency,
+		"currency", "does not match the currency of amount")
+	v.required(r.Recipient, "recipient")
+	v.transferReference(r.Reference, "reference")
+
+	return v.err()
+}
+
+// TransferResponse represents the response body for the InitiateTransfer, FinalizeTransfer,
+// FetchTransfer and VerifyTransfer APIs.
+type TransferResponse = Response[Transfer]
+
+// FinalizeTransferRequest represents the body parameters for the FinalizeTransfer API.
+type FinalizeTransferRequest struct {
+	TransferCode string `json:"transfer_code"`
+	OTP          string `json:"otp"`
+}
+
+// Validate checks the request before it is sent.
+func (r *FinalizeTransferRequest) Validate() error {
+	v := validation{}
+	v.required(r.TransferCode, "transfer_code")
+	v.required(r.OTP, "otp")
+
+	return v.err()
+}
+
+// BulkTransferItem represents a single transfer in a bulk transfer.
+type BulkTransferItem struct {
+	// Amount must be in the currency of the bulk transfer.
+	Amount    Money  `json:"amount"`
+	Recipient string `json:"recipient"`
+	Reference string `json:"reference,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// Validate checks the transfer before it is sent.
+func (i *BulkTransferItem) Validate() error {
+	v := validation{}
+	v.positive(i.Amount.Amount, "amount")
+	v.currency(i.Amount.Currency, "amount")
+	v.required(i.Recipient, "recipient")
+	v.transferReference(i.Reference, "reference")
+
+	return v.err()
+}
+
+// InitiateBulkTransferRequest represents the body parameters for the InitiateBulkTransfer API.
+// Bulk transfers cannot be used while transfer OTPs are enabled.
+type InitiateBulkTransferRequest struct {
+	// Source is where the money comes from; it defaults to "balance".
+	Source    string             `json:"source"`
+	Currency  Currency           `json:"currency,omitempty"`
+	Transfers []BulkTransferItem `json:"transfers"`
+}
+
+// MarshalJSON fills in the source and the currency from the transfer
+// amounts when they are not set.
+func (r InitiateBulkTransferRequest) MarshalJSON() ([]byte, error) {
+	type request InitiateBulkTransferRequest
+	if r.Source == "" {
+		r.Source = "balance"
+	}
+	if r.Currency == "" {
+		r.Currency = r.amountCurrency()
+	}
+
+	return json.Marshal(request(r))
+}
+
+// amountCurrency returns the currency of the first transfer amount that has one.
+func (r *InitiateBulkTransferRequest) amountCurrency() Currency {
+	for _, transfer := range r.Transfers {
+		if transfer.Amount.Currency != "" {
+			return transfer.Amount.Currency
+		}
+	}
+
+	return ""
+}
+
+// Validate checks the request before it is sent.
+func (r *InitiateBulkTransferRequest) Validate() error {
+	v := validation{}
+	v.currency(r.Currency, "currency")
+	v.check(len(r.Transfers) > 0, "transfers", "is required")
+	currency := r.Currency
+	if currency == "" {
+		currency = r.amountCurrency()
+	}
+	for i := range r.Transfers {
+		field := fmt.Sprintf("transfers[%d]", i)
+		v.nested(field, r.Transfers[i].Validate())
+		v.check(r.Transfers[i].Amount.Currency == "" || r.Transfers[i].Amount.Currency == currency,
+			field+".amount", "does not match the currency of the bulk transfer")
+	}
+
+	return v.err()
+}
+
+// BulkTransfer represents a transfer queued by the InitiateBulkTransfer API.
+type BulkTransfer struct {
+	Reference    string         `json:"reference"`
+	Recipient    string         `json:"recipient"`
+	Amount       Money          `json:"amount"`
+	TransferCode string         `json:"transfer_code"`
+	Currency     Currency       `json:"currency"`
+	Status       TransferStatus `json:"status"`
+}
+
+// UnmarshalJSON decodes a queued transfer and sets the currency of its amount.
+func (t *BulkTransfer) UnmarshalJSON(data []byte) error {
+	type bulkTransfer BulkTransfer
+	if err := json.Unmarshal(data, (*bulkTransfer)(t)); err != nil {
+		return err
+	}
+
+	t.Amount.Currency = t.Currency
+	return nil
+}
+
+// BulkTransferResponse represents the response body for the InitiateBulkTransfer API.
+type BulkTransferResponse = Response[[]BulkTransfer]
+
+// ListTransfersRequest represents the query parameters for the ListTransfers API.
+type ListTransfersRequest struct {
+	PerPage int `url:"perPage,omitempty"`
+	Page    int `url:"page,omitempty"`
+	// Recipient filters by the ID of the transfer recipient.
+	Recipient int            `url:"recipient,omitempty"`
+	Status    TransferStatus `url:"status,omitempty"`
+	From      time.Time      `url:"from,omitempty"`
+	To        time.Time      `url:"to,omitempty"`
+}
+
+// Validate checks the request before it is sent.
+func (r *ListTransfersRequest) Validate() error {
+	v := validation{}
+	v.paging(r.PerPage, r.Page)
+	v.dateRange(r.From, r.To)
+
+	return v.err()
+}
+
+// ListTransfersResponse represents the response body for the ListTransfers API.
+type ListTransfersResponse = Response[[]Transfer]
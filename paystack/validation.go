@@ -0,0 +1,147 @@
+package paystack
+
+import (
+	"fmt"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// FieldError describes why a single request field is invalid.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationError is returned, before any request is sent, when a request
+// fails client-side validation. It lists every offending field.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		messages[i] = fieldErr.Error()
+	}
+
+	return fmt.Sprintf("paystack: invalid request: %s", strings.Join(messages, "; "))
+}
+
+// validator is implemented by request types that can check themselves
+// before being sent.
+type validator interface {
+	Validate() error
+}
+
+// validate runs req's Validate method when it has one. Nil requests are
+// left for the API to judge.
+func validate(req interface{}) error {
+	v, ok := req.(validator)
+	if !ok {
+		return nil
+	}
+
+	if rv := reflect.ValueOf(req); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil
+	}
+
+	return v.Validate()
+}
+
+// referencePattern matches the characters Paystack allows in references.
+var referencePattern = regexp.MustCompile(`^[A-Za-z0-9.=-]+$`)
+
+// transferReferencePattern matches the characters Paystack allows in
+// transfer references, which differ from transaction references.
+var transferReferencePattern = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// validation collects field errors while a request is checked.
+type validation struct {
+	errors []FieldError
+}
+
+// check records message against field unless ok holds.
+func (v *validation) check(ok bool, field, message string) {
+	if !ok {
+		v.errors = append(v.errors, FieldError{Field: field, Message: message})
+	}
+}
+
+func (v *validation) required(value, field string) {
+	v.check(strings.TrimSpace(value) != "", field, "is required")
+}
+
+func (v *validation) email(value, field string) {
+	if value == "" {
+		v.required(value, field)
+		return
+	}
+
+	address, err := mail.ParseAddress(value)
+	v.check(err == nil && address.Address == value, field, "must be a valid email address")
+}
+
+func (v *validation) positive(amount int64, field string) {
+	v.check(amount > 0, field, "must be greater than zero")
+}
+
+// percentage checks that a percentage lies between 0 and 100.
+func (v *validation) percentage(value float64, field string) {
+	v.check(value >= 0 && value <= 100, field, "must be between 0 and 100")
+}
+
+// currency checks that a set currency is one Paystack supports.
+func (v *validation) currency(currency Currency, field string) {
+	v.check(currency == "" || currency.IsSupported(), field, fmt.Sprintf("unsupported currency %q", currency))
+}
+
+// reference checks that a set reference only uses allowed characters.
+func (v *validation) reference(reference, field string) {
+	v.check(reference == "" || referencePattern.MatchString(reference), field,
+		"may only contain letters, digits, '-', '.' and '='")
+}
+
+// transferReference checks that a set transfer reference only uses allowed
+// characters.
+func (v *validation) transferReference(reference, field string) {
+	v.check(reference == "" || transferReferencePattern.MatchString(reference), field,
+		"may only contain lowercase letters, digits, '-' and '_'")
+}
+
+func (v *validation) paging(perPage, page int) {
+	v.check(perPage >= 0, "perPage", "must not be negative")
+	v.check(page >= 0, "page", "must not be negative")
+}
+
+func (v *validation) dateRange(from, to time.Time) {
+	v.check(from.IsZero() || to.IsZero() || !to.Before(from), "to", "must not be before from")
+}
+
+// nested records the field errors of a nested value under prefix, e.g.
+// "split.type" for the type of a transaction's split.
+func (v *validation) nested(prefix string, err error) {
+	validationErr, ok := err.(*ValidationError)
+	if !ok {
+		return
+	}
+
+	for _, fieldErr := range validationErr.Errors {
+		v.errors = append(v.errors, FieldError{Field: prefix + "." + fieldErr.Field, Message: fieldErr.Message})
+	}
+}
+
+// err returns the collected errors as a *ValidationError, or nil.
+func (v *validation) err() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Errors: v.errors}
+}
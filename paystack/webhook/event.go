@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// EventType is the name of a Paystack webhook event, e.g. "charge.success".
+type EventType string
+
+// Event types sent by Paystack.
+const (
+	EventChargeSuccess        EventType = "charge.success"
+	EventChargeDisputeCreate  EventType = "charge.dispute.create"
+	EventChargeDisputeRemind  EventType = "charge.dispute.remind"
+	EventChargeDisputeResolve EventType = "charge.dispute.resolve"
+
+	EventCustomerIdentificationSuccess EventType = "customeridentification.success"
+	EventCustomerIdentificationFailed  EventType = "customeridentification.failed"
+
+	EventDedicatedAccountAssignSuccess EventType = "dedicatedaccount.assign.success"
+	EventDedicatedAccountAssignFailed  EventType = "dedicatedaccount.assign.failed"
+
+	EventInvoiceCreate        EventType = "invoice.create"
+	EventInvoiceUpdate        EventType = "invoice.update"
+	EventInvoicePaymentFailed EventType = "invoice.payment_failed"
+
+	EventPaymentRequestPending EventType = "paymentrequest.pending"
+	EventPaymentRequestSuccess EventType = "paymentrequest.success"
+
+	EventRefundPending    EventType = "refund.pending"
+	EventRefundProcessing EventType = "refund.processing"
+	EventRefundProcessed  EventType = "refund.processed"
+	EventRefundFailed     EventType = "refund.failed"
+
+	EventSubscriptionCreate        EventType = "subscription.create"
+	EventSubscriptionDisable       EventType = "subscription.disable"
+	EventSubscriptionNotRenew      EventType = "subscription.not_renew"
+	EventSubscriptionExpiringCards EventType = "subscription.expiring_cards"
+
+	EventTransferSuccess  EventType = "transfer.success"
+	EventTransferFailed   EventType = "transfer.failed"
+	EventTransferReversed EventType = "transfer.reversed"
+)
+
+// Event is the envelope every Paystack webhook is delivered in. Data holds
+// the raw event payload; use DecodeData to read it into a Go value.
+type Event struct {
+	Type EventType       `json:"event"`
+	Data json.RawMessage `json:"data"`
+}
+
+// ParseEvent parses a webhook request body into an Event.
+func ParseEvent(body []byte) (*Event, error) {
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("error parsing event: %w", err)
+	}
+	if event.Type == "" {
+		return nil, fmt.Errorf("error parsing event: missing event type")
+	}
+
+	return &event, nil
+}
+
+// DecodeData unmarshals the event payload into v.
+func (e *Event) DecodeData(v interface{}) error {
+	if err := json.Unmarshal(e.Data, v); err != nil {
+		return fmt.Errorf("error parsing %s event data: %w", e.Type, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aglili/gopaystack/paystack"
+)
+
+// TypedEvent is implemented by every decoded webhook event. Use a type
+// switch on the value returned by Decode to reach the payload:
+//
+//	switch e := typed.(type) {
+//	case *webhook.ChargeEvent:
+//	    fmt.Println(e.Data.Reference)
+//	case *webhook.RawEvent:
+//	    // an event type this package does not model yet
+//	}
+type TypedEvent interface {
+	EventType() EventType
+}
+
+// ChargeEvent is delivered for charge.success.
+type ChargeEvent struct {
+	Type EventType            `json:"event"`
+	Data paystack.Transaction `json:"data"`
+}
+
+// TransferEvent is delivered for transfer.success, transfer.failed and
+// transfer.reversed.
+type TransferEvent struct {
+	Type EventType    `json:"event"`
+	Data TransferData `json:"data"`
+}
+
+// SubscriptionEvent is delivered for subscription.create, subscription.disable,
+// subscription.not_renew and subscription.expiring_cards.
+type SubscriptionEvent struct {
+	Type EventType        `json:"event"`
+	Data SubscriptionData `json:"data"`
+}
+
+// InvoiceEvent is delivered for invoice.create, invoice.update and
+// invoice.payment_failed.
+type InvoiceEvent struct {
+	Type EventType   `json:"event"`
+	Data InvoiceData `json:"data"`
+}
+
+// RefundEvent is delivered for refund.pending, refund.processing,
+// refund.processed and refund.failed.
+type RefundEvent struct {
+	Type EventType  `json:"event"`
+	Data RefundData `json:"data"`
+}
+
+// DisputeEvent is delivered for charge.dispute.create, charge.dispute.remind
+// and charge.dispute.resolve.
+type DisputeEvent struct {
+	Type EventType   `json:"event"`
+	Data DisputeData `json:"data"`
+}
+
+// CustomerIdentificationEvent is delivered for customeridentification.success
+// and customeridentification.failed.
+type CustomerIdentificationEvent struct {
+	Type EventType                  `json:"event"`
+	Data CustomerIdentificationData `json:"data"`
+}
+
+// DedicatedAccountEvent is delivered for dedicatedaccount.assign.success and
+// dedicatedaccount.assign.failed.
+type DedicatedAccountEvent struct {
+	Type EventType            `json:"event"`
+	Data DedicatedAccountData `json:"data"`
+}
+
+// PaymentRequestEvent is delivered for paymentrequest.pending and
+// paymentrequest.success.
+type PaymentRequestEvent struct {
+	Type EventType          `json:"event"`
+	Data PaymentRequestData `json:"data"`
+}
+
+// RawEvent is returned by Decode for event types this package does not
+// model, so that new Paystack events never make decoding fail.
+type RawEvent struct {
+	Type EventType       `json:"event"`
+	Data json.RawMessage `json:"data"`
+}
+
+func (e *ChargeEvent) EventType() EventType                 { return e.Type }
+func (e *TransferEvent) EventType() EventType               { return e.Type }
+func (e *SubscriptionEvent) EventType() EventType           { return e.Type }
+func (e *InvoiceEvent) EventType() EventType                { return e.Type }
+func (e *RefundEvent) EventType() EventType                 { return e.Type }
+func (e *DisputeEvent) EventType() EventType                { return e.Type }
+func (e *CustomerIdentificationEvent) EventType() EventType { return e.Type }
+func (e *DedicatedAccountEvent) EventType() EventType       { return e.Type }
+func (e *PaymentRequestEvent) EventType() EventType         { return e.Type }
+func (e *RawEvent) EventType() EventType                    { return e.Type }
+
+// Decode parses a webhook request body into the concrete event type for its
+// "event" field.
+func Decode(body []byte) (TypedEvent, error) {
+	event, err := ParseEvent(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return event.Decode()
+}
+
+// Decode turns the event into the concrete type for its event type. Unknown
+// event types decode into a *RawEvent rather than failing.
+func (e *Event) Decode() (TypedEvent, error) {
+	var typed TypedEvent
+	var data interface{}
+
+	switch {
+	case e.Type == EventChargeSuccess:
+		event := &ChargeEvent{Type: e.Type}
+		typed, data = event, &event.Data
+	case strings.HasPrefix(string(e.Type), "charge.dispute."):
+		event := &DisputeEvent{Type: e.Type}
+		typed, data = event, &event.Data
+	case strings.HasPrefix(string(e.Type), "transfer."):
+		event := &TransferEvent{Type: e.Type}
+		typed, data = event, &event.Data
+	case strings.HasPrefix(string(e.Type), "subscription."):
+		event := &SubscriptionEvent{Type: e.Type}
+		typed, data = event, &event.Data
+	case strings.HasPrefix(string(e.Type), "invoice."):
+		event := &InvoiceEvent{Type: e.Type}
+		typed, data = event, &event.Data
+	case strings.HasPrefix(string(e.Type), "refund."):
+		event := &RefundEvent{Type: e.Type}
+		typed, data = event, &event.Data
+	case strings.HasPrefix(string(e.Type), "customeridentification."):
+		event := &CustomerIdentificationEvent{Type: e.Type}
+		typed, data = event, &event.Data
+	case strings.HasPrefix(string(e.Type), "dedicatedaccount."):
+		event := &DedicatedAccountEvent{Type: e.Type}
+		typed, data = event, &event.Data
+	case strings.HasPrefix(string(e.Type), "paymentrequest."):
+		event := &PaymentRequestEvent{Type: e.Type}
+		typed, data = event, &event.Data
+	default:
+		return &RawEvent{Type: e.Type, Data: e.Data}, nil
+	}
+
+	if len(e.Data) > 0 {
+		if err := json.Unmarshal(e.Data, data); err != nil {
+			return nil, fmt.Errorf("error parsing %s event data: %w", e.Type, err)
+		}
+	}
+
+	return typed, nil
+}
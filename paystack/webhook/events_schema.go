@@ -0,0 +1,290 @@
+package webhook
+
+import (
+	"encoding/json"
+
+	"github.com/aglili/gopaystack/paystack"
+)
+
+// Integration identifies the Paystack integration an event belongs to.
+type Integration struct {
+	ID           int    `json:"id"`
+	IsLive       bool   `json:"is_live"`
+	BusinessName string `json:"business_name"`
+}
+
+// TransferData represents the payload of transfer events.
+type TransferData struct {
+	ID            int               `json:"id"`
+	Amount        paystack.Money    `json:"amount"`
+	Currency      paystack.Currency `json:"currency"`
+	Domain        string            `json:"domain"`
+	Failures      interface{}       `json:"failures"`
+	Integration   Integration       `json:"integration"`
+	Reason        string            `json:"reason"`
+	Reference     string            `json:"reference"`
+	Source        string            `json:"source"`
+	Status        string            `json:"status"`
+	TransferCode  string            `json:"transfer_code"`
+	TransferredAt string            `json:"transferred_at"`
+	Recipient     TransferRecipient `json:"recipient"`
+	CreatedAt     string            `json:"created_at"`
+	UpdatedAt     string            `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes a transfer and sets the currency of its amount.
+func (t *TransferData) UnmarshalJSON(data []byte) error {
+	type transferData TransferData
+	if err := json.Unmarshal(data, (*transferData)(t)); err != nil {
+		return err
+	}
+
+	t.Amount.Currency = t.Currency
+	return nil
+}
+
+// TransferRecipient represents the recipient of a transfer.
+type TransferRecipient struct {
+	ID            int                    `json:"id"`
+	Active        bool                   `json:"active"`
+	Currency      paystack.Currency      `json:"currency"`
+	Description   string                 `json:"description"`
+	Domain        string                 `json:"domain"`
+	Email         string                 `json:"email"`
+	Name          string                 `json:"name"`
+	RecipientCode string                 `json:"recipient_code"`
+	Type          string                 `json:"type"`
+	Metadata      map[string]interface{} `json:"metadata"`
+	Details       struct {
+		AccountNumber string `json:"account_number"`
+		AccountName   string `json:"account_name"`
+		BankCode      string `json:"bank_code"`
+		BankName      string `json:"bank_name"`
+	} `json:"details"`
+}
+
+// SubscriptionData represents the payload of subscription events.
+type SubscriptionData struct {
+	Domain           string                 `json:"domain"`
+	Status           string                 `json:"status"`
+	SubscriptionCode string                 `json:"subscription_code"`
+	EmailToken       string                 `json:"email_token"`
+	Amount           paystack.Money         `json:"amount"`
+	CronExpression   string                 `json:"cron_expression"`
+	NextPaymentDate  string                 `json:"next_payment_date"`
+	OpenInvoice      string                 `json:"open_invoice"`
+	Plan             paystack.Plan          `json:"plan"`
+	Authorization    paystack.Authorization `json:"authorization"`
+	Customer         paystack.Customer      `json:"customer"`
+	CreatedAt        string                 `json:"created_at"`
+}
+
+// UnmarshalJSON decodes a subscription and sets the currency of its amount
+// from the plan.
+func (s *SubscriptionData) UnmarshalJSON(data []byte) error {
+	type subscriptionData SubscriptionData
+	if err := json.Unmarshal(data, (*subscriptionData)(s)); err != nil {
+		return err
+	}
+
+	s.Amount.Currency = s.Plan.Currency
+	return nil
+}
+
+// InvoiceData represents the payload of invoice events.
+type InvoiceData struct {
+	Domain        string                 `json:"domain"`
+	InvoiceCode   string                 `json:"invoice_code"`
+	Amount        paystack.Money         `json:"amount"`
+	PeriodStart   string                 `json:"period_start"`
+	PeriodEnd     string                 `json:"period_end"`
+	Status        string                 `json:"status"`
+	Paid          bool                   `json:"paid"`
+	PaidAt        string                 `json:"paid_at"`
+	Description   string                 `json:"description"`
+	Authorization paystack.Authorization `json:"authorization"`
+	Subscription  SubscriptionData       `json:"subscription"`
+	Customer      paystack.Customer      `json:"customer"`
+	Transaction   struct {
+		Reference string            `json:"reference"`
+		Status    string            `json:"status"`
+		Amount    paystack.Money    `json:"amount"`
+		Currency  paystack.Currency `json:"currency"`
+	} `json:"transaction"`
+	CreatedAt string `json:"created_at"`
+}
+
+// UnmarshalJSON decodes an invoice and sets the currency of its amounts
+// from its transaction, falling back to the subscription's plan.
+func (i *InvoiceData) UnmarshalJSON(data []byte) error {
+	type invoiceData InvoiceData
+	if err := json.Unmarshal(data, (*invoiceData)(i)); err != nil {
+		return err
+	}
+
+	currency := i.Transaction.Currency
+	if currency == "" {
+		currency = i.Subscription.Plan.Currency
+	}
+	i.Amount.Currency = currency
+	i.Transaction.Amount.Currency = currency
+	return nil
+}
+
+// RefundData represents the payload of refund events.
+type RefundData struct {
+	ID                   int               `json:"id"`
+	Status               string            `json:"status"`
+	TransactionReference string            `json:"transaction_reference"`
+	RefundReference      string            `json:"refund_reference"`
+	Amount               paystack.Money    `json:"amount"`
+	Currency             paystack.Currency `json:"currency"`
+	Processor            string            `json:"processor"`
+	Domain               string            `json:"domain"`
+	Integration          int               `json:"integration"`
+	Customer             struct {
+		FirstName string `json:"first_name"`
+		LastName  string `json:"last_name"`
+		Email     string `json:"email"`
+	} `json:"customer"`
+}
+
+// UnmarshalJSON decodes a refund and sets the currency of its amount.
+func (r *RefundData) UnmarshalJSON(data []byte) error {
+	type refundData RefundData
+	if err := json.Unmarshal(data, (*refundData)(r)); err != nil {
+		return err
+	}
+
+	r.Amount.Currency = r.Currency
+	return nil
+}
+
+// DisputeData represents the payload of dispute events.
+type DisputeData struct {
+	ID           int                  `json:"id"`
+	RefundAmount paystack.Money       `json:"refund_amount"`
+	Currency     paystack.Currency    `json:"currency"`
+	Status       string               `json:"status"`
+	Resolution   string               `json:"resolution"`
+	Domain       string               `json:"domain"`
+	Category     string               `json:"category"`
+	Note         string               `json:"note"`
+	Bin          string               `json:"bin"`
+	Last4        string               `json:"last4"`
+	Transaction  paystack.Transaction `json:"transaction"`
+	Customer     paystack.Customer    `json:"customer"`
+	History      []struct {
+		Status    string `json:"status"`
+		By        string `json:"by"`
+		CreatedAt string `json:"createdAt"`
+	} `json:"history"`
+	Messages []struct {
+		Sender    string `json:"sender"`
+		Body      string `json:"body"`
+		CreatedAt string `json:"createdAt"`
+	} `json:"messages"`
+	DueAt      string `json:"dueAt"`
+	ResolvedAt string `json:"resolvedAt"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+// UnmarshalJSON decodes a dispute and sets the currency of its refund amount.
+func (d *DisputeData) UnmarshalJSON(data []byte) error {
+	type disputeData DisputeData
+	if err := json.Unmarshal(data, (*disputeData)(d)); err != nil {
+		return err
+	}
+
+	d.RefundAmount.Currency = d.Currency
+	return nil
+}
+
+// CustomerIdentificationData represents the payload of customer
+// identification events.
+type CustomerIdentificationData struct {
+	CustomerID     string `json:"customer_id"`
+	CustomerCode   string `json:"customer_code"`
+	Email          string `json:"email"`
+	Reason         string `json:"reason"`
+	Identification struct {
+		Country       string `json:"country"`
+		Type          string `json:"type"`
+		BVN           string `json:"bvn"`
+		AccountNumber string `json:"account_number"`
+		BankCode      string `json:"bank_code"`
+	} `json:"identification"`
+}
+
+// DedicatedAccountData represents the payload of dedicated account events.
+type DedicatedAccountData struct {
+	Customer         paystack.Customer `json:"customer"`
+	DedicatedAccount *struct {
+		ID            int               `json:"id"`
+		AccountName   string            `json:"account_name"`
+		AccountNumber string            `json:"account_number"`
+		Assigned      bool              `json:"assigned"`
+		Currency      paystack.Currency `json:"currency"`
+		Active        bool              `json:"active"`
+		Bank          struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+			Slug string `json:"slug"`
+		} `json:"bank"`
+		CreatedAt string `json:"created_at"`
+	} `json:"dedicated_account"`
+	Identification struct {
+		Status string `json:"status"`
+	} `json:"identification"`
+}
+
+// PaymentRequestData represents the payload of payment request events.
+type PaymentRequestData struct {
+	ID               int                    `json:"id"`
+	Domain           string                 `json:"domain"`
+	Amount           paystack.Money         `json:"amount"`
+	Currency         paystack.Currency      `json:"currency"`
+	DueDate          string                 `json:"due_date"`
+	HasInvoice       bool                   `json:"has_invoice"`
+	InvoiceNumber    int                    `json:"invoice_number"`
+	Description      string                 `json:"description"`
+	PDFURL           string                 `json:"pdf_url"`
+	LineItems        []PaymentRequestItem   `json:"line_items"`
+	Tax              []PaymentRequestItem   `json:"tax"`
+	RequestCode      string                 `json:"request_code"`
+	Status           string                 `json:"status"`
+	Paid             bool                   `json:"paid"`
+	PaidAt           string                 `json:"paid_at"`
+	Metadata         map[string]interface{} `json:"metadata"`
+	OfflineReference string                 `json:"offline_reference"`
+	Customer         int                    `json:"customer"`
+	CreatedAt        string                 `json:"created_at"`
+	Notifications    []struct {
+		SentAt  string `json:"sent_at"`
+		Channel string `json:"channel"`
+	} `json:"notifications"`
+}
+
+// UnmarshalJSON decodes a payment request and sets the currency of its
+// amount, line items and taxes.
+func (p *PaymentRequestData) UnmarshalJSON(data []byte) error {
+	type paymentRequestData PaymentRequestData
+	if err := json.Unmarshal(data, (*paymentRequestData)(p)); err != nil {
+		return err
+	}
+
+	p.Amount.Currency = p.Currency
+	for i := range p.LineItems {
+		p.LineItems[i].Amount.Currency = p.Currency
+	}
+	for i := range p.Tax {
+		p.Tax[i].Amount.Currency = p.Currency
+	}
+	return nil
+}
+
+// PaymentRequestItem represents a line item or tax entry on a payment request.
+type PaymentRequestItem struct {
+	Name   string         `json:"name"`
+	Amount paystack.Money `json:"amount"`
+}
@@ -0,0 +1,129 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// SignatureHeader is the header carrying the HMAC-SHA512 signature of the
+// webhook body, keyed with the integration's secret key.
+const SignatureHeader = "x-paystack-signature"
+
+// maxBodyBytes bounds the size of webhook bodies the Handler accepts.
+const maxBodyBytes = 1 << 20
+
+// HandlerFunc handles a verified webhook event. Returning an error makes
+// the Handler answer with a 500 so Paystack retries the delivery.
+type HandlerFunc func(ctx context.Context, event *Event) error
+
+// Handler is an http.Handler that verifies Paystack webhooks and dispatches
+// them to the callbacks registered for their event type. Events without a
+// registered callback are acknowledged and otherwise ignored.
+type Handler struct {
+	secretKey string
+
+	mu       sync.RWMutex
+	handlers map[EventType][]HandlerFunc
+	fallback HandlerFunc
+}
+
+// NewHandler returns a Handler verifying signatures with the given secret
+// key, the same key the paystack.Client is created with.
+func NewHandler(secretKey string) *Handler {
+	return &Handler{
+		secretKey: secretKey,
+		handlers:  make(map[EventType][]HandlerFunc),
+	}
+}
+
+// On registers fn to be called for events of the given type. Several
+// callbacks may be registered for one type; they run in registration order
+// and dispatch stops at the first error.
+func (h *Handler) On(eventType EventType, fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handlers[eventType] = append(h.handlers[eventType], fn)
+}
+
+// OnUnhandled registers fn to be called for events that have no callback
+// registered with On.
+func (h *Handler) OnUnhandled(fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.fallback = fn
+}
+
+// ServeHTTP verifies the request signature, parses the event and dispatches
+// it. It answers 405 for non-POST requests, 401 for a bad signature, 400 for
+// a malformed body, 500 when a callback fails and 200 otherwise.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+		http.Error(w, "error reading body", http.StatusBadRequest)
+		return
+	}
+
+	if !VerifySignature(h.secretKey, body, r.Header.Get(SignatureHeader)) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	event, err := ParseEvent(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.dispatch(r.Context(), event); err != nil {
+		http.Error(w, "error handling event", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) dispatch(ctx context.Context, event *Event) error {
+	h.mu.RLock()
+	handlers := h.handlers[event.Type]
+	fallback := h.fallback
+	h.mu.RUnlock()
+
+	if len(handlers) == 0 && fallback != nil {
+		handlers = []HandlerFunc{fallback}
+	}
+
+	for _, fn := range handlers {
+		if err := fn(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// VerifySignature reports whether signature is the hex encoded
+// HMAC-SHA512 of body keyed with secretKey.
+func VerifySignature(secretKey string, body []byte, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || len(expected) == 0 {
+		return false
+	}
+
+	mac := hmac.New(sha512.New, []byte(secretKey))
+	mac.Write(body)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
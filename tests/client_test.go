@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientOptions(t *testing.T) {
+	// mock the response
+	Response := paystack.ListPlansResponse{
+		Status:  true,
+		Message: "Plans retrieved",
+	}
+
+	// create two mock servers so each client must use its own base URL
+	newServer := func(name string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.URL.Path, "/plan")
+			assert.Equal(t, r.Header.Get("Authorization"), "Bearer sk_test_1234567890")
+			assert.Equal(t, r.Header.Get("User-Agent"), "gopaystack-test")
+
+			resp := Response
+			resp.Message = name
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(resp)
+		}))
+	}
+
+	live := newServer("live")
+	defer live.Close()
+	local := newServer("local")
+	defer local.Close()
+
+	liveClient := paystack.NewClient("sk_test_1234567890",
+		paystack.WithBaseURL(live.URL+"/"),
+		paystack.WithUserAgent("gopaystack-test"),
+		paystack.WithHTTPClient(&http.Client{}),
+		paystack.WithTimeout(5*time.Second),
+	)
+	localClient := paystack.NewClient("sk_test_1234567890",
+		paystack.WithBaseURL(local.URL),
+		paystack.WithUserAgent("gopaystack-test"),
+	)
+
+	res, err := liveClient.ListPlans(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "live")
+
+	res, err = localClient.ListPlans(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "local")
+}
+
+func TestClientContextCancellation(t *testing.T) {
+	// create a mock server that never answers before the deadline
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	res, err := client.VerifyTransaction(ctx, "9k2f3k4")
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClientEscapesPathSegments(t *testing.T) {
+	// create a mock server that records the requested URLs
+	var paths, queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath())
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":true,"message":"ok","data":{}}`))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	_, err := client.GetCustomer(context.Background(), "a?b@x.com")
+	assert.NoError(t, err)
+
+	_, err = client.VerifyTransaction(context.Background(), "../refund#1")
+	assert.NoError(t, err)
+
+	_, err = client.GetDisputeUploadURL(context.Background(), "2867/x", "receipt.pdf")
+	assert.NoError(t, err)
+
+	assert.Equal(t, paths, []string{"/customer/a%3Fb@x.com", "/transaction/verify/..%2Frefund%231", "/dispute/2867%2Fx/upload_url"})
+	assert.Equal(t, queries, []string{"", "", "upload_filename=receipt.pdf"})
+}
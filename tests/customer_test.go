@@ -1,13 +1,13 @@
 package tests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
-	"github.com/aglili/gopaystack/config"
 	"github.com/aglili/gopaystack/paystack"
 	"github.com/stretchr/testify/assert"
 )
@@ -17,15 +17,7 @@ func TestCreateCustomer(t *testing.T) {
 	Response := paystack.CustomerResponse{
 		Status:  true,
 		Message: "Customer created",
-		Data: struct {
-			ID           int                    `json:"id"`
-			FirstName    string                 `json:"first_name"`
-			LastName     string                 `json:"last_name"`
-			Email        string                 `json:"email"`
-			Phone        string                 `json:"phone"`
-			CustomerCode string                 `json:"customer_code"`
-			Metadata     map[string]interface{} `json:"metadata"`
-		}{
+		Data: paystack.Customer{
 			ID:           1,
 			CustomerCode: "CUS_1234567890",
 			FirstName:    "John",
@@ -47,9 +39,7 @@ func TestCreateCustomer(t *testing.T) {
 
 	defer server.Close()
 
-	config.BaseURL = server.URL
-
-	client := paystack.NewClient("sk_test_1234567890")
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
 
 	req := &paystack.CreateCustomerRequest{
 		FirstName: "John",
@@ -58,7 +48,7 @@ func TestCreateCustomer(t *testing.T) {
 		Phone:     "1234567890",
 	}
 
-	res, err := client.CreateCustomer(req)
+	res, err := client.CreateCustomer(context.Background(), req)
 	assert.Nil(t, err)
 	assert.NoError(t, err)
 	assert.Equal(t, res.Status, true)
@@ -74,15 +64,7 @@ func TestListCustomers(t *testing.T) {
 	Response := paystack.ListCustomersResponse{
 		Status:  true,
 		Message: "Customers fetched",
-		Data: []struct {
-			ID           int                    `json:"id"`
-			FirstName    string                 `json:"first_name"`
-			LastName     string                 `json:"last_name"`
-			Email        string                 `json:"email"`
-			Phone        string                 `json:"phone"`
-			CustomerCode string                 `json:"customer_code"`
-			Metadata     map[string]interface{} `json:"metadata"`
-		}{
+		Data: []paystack.Customer{
 			{
 				ID:           1,
 				CustomerCode: "CUS_1234567890",
@@ -106,6 +88,9 @@ func TestListCustomers(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, r.Method, "GET")
 		assert.Equal(t, r.URL.Path, "/customer")
+		assert.Equal(t, r.URL.Query().Get("perPage"), "10")
+		assert.False(t, r.URL.Query().Has("page"))
+		assert.Equal(t, r.ContentLength, int64(0))
 
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(Response)
@@ -113,15 +98,13 @@ func TestListCustomers(t *testing.T) {
 
 	defer server.Close()
 
-	config.BaseURL = server.URL
-
-	client := paystack.NewClient("sk_test_1234567890")
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
 
 	req := &paystack.ListCustomersRequest{
 		PerPage: 10,
 	}
 
-	res, err := client.ListCustomers(req)
+	res, err := client.ListCustomers(context.Background(), req)
 	assert.Nil(t, err)
 	assert.NoError(t, err)
 	assert.Equal(t, res.Status, true)
@@ -141,35 +124,16 @@ func TestGetCustomer(t *testing.T) {
 	Response := paystack.GetCustomerResponse{
 		Status:  true,
 		Message: "Customer fetched",
-		Data: struct {
-			ID            int    `json:"id"`
-			FirstName     string `json:"first_name"`
-			LastName      string `json:"last_name"`
-			Email         string `json:"email"`
-			Phone         string `json:"phone"`
-			CustomerCode  string `json:"customer_code"`
-			Subscriptions []struct {
-				ID               int    `json:"id"`
-				Plan             string `json:"plan"`
-				SubscriptionCode string `json:"subscription_code"`
-			} `json:"subscriptions"`
-			Transaction []struct {
-				ID        int    `json:"id"`
-				Amount    int    `json:"amount"`
-				Reference string `json:"reference"`
-			} `json:"transactions"`
-		}{
-			ID:           1,
-			FirstName:    "John",
-			LastName:     "Doe",
-			Email:        "test@test.com",
-			Phone:        "1234567890",
-			CustomerCode: "CUS_1234567890",
-			Subscriptions: []struct {
-				ID               int    `json:"id"`
-				Plan             string `json:"plan"`
-				SubscriptionCode string `json:"subscription_code"`
-			}{
+		Data: paystack.CustomerDetails{
+			Customer: paystack.Customer{
+				ID:           1,
+				FirstName:    "John",
+				LastName:     "Doe",
+				Email:        "test@test.com",
+				Phone:        "1234567890",
+				CustomerCode: "CUS_1234567890",
+			},
+			Subscriptions: []paystack.CustomerSubscription{
 				{
 					ID:               1,
 					Plan:             "Premium",
@@ -181,19 +145,17 @@ func TestGetCustomer(t *testing.T) {
 					SubscriptionCode: "SUB_4875158",
 				},
 			},
-			Transaction: []struct {
-				ID        int    `json:"id"`
-				Amount    int    `json:"amount"`
-				Reference string `json:"reference"`
-			}{
+			Transaction: []paystack.CustomerTransaction{
 				{
 					ID:        1,
-					Amount:    500,
+					Amount:    paystack.NewMoney(500, paystack.CurrencyNGN),
+					Currency:  paystack.CurrencyNGN,
 					Reference: "PremiumPay",
 				},
 				{
 					ID:        2,
-					Amount:    1000,
+					Amount:    paystack.NewMoney(1000, paystack.CurrencyNGN),
+					Currency:  paystack.CurrencyNGN,
 					Reference: "VIPPay",
 				},
 			},
@@ -210,11 +172,9 @@ func TestGetCustomer(t *testing.T) {
 
 	defer server.Close()
 
-	config.BaseURL = server.URL
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
 
-	client := paystack.NewClient("sk_test_1234567890")
-
-	res, err := client.GetCustomer(customerCodeOrEmail)
+	res, err := client.GetCustomer(context.Background(), customerCodeOrEmail)
 	assert.Nil(t, err)
 	assert.NoError(t, err)
 	assert.Equal(t, res.Status, true)
@@ -229,6 +189,7 @@ func TestGetCustomer(t *testing.T) {
 	assert.Equal(t, res.Data.Subscriptions[1].ID, 2)
 	assert.Equal(t, res.Data.Transaction[0].ID, 1)
 	assert.Equal(t, res.Data.Transaction[1].ID, 2)
+	assert.Equal(t, res.Data.Transaction[1].Amount, paystack.NewMoney(1000, paystack.CurrencyNGN))
 }
 
 func TestUpdateCustomer(t *testing.T) {
@@ -237,15 +198,7 @@ func TestUpdateCustomer(t *testing.T) {
 	Response := paystack.CustomerResponse{
 		Status:  true,
 		Message: "Customer updated",
-		Data: struct {
-			ID           int                    `json:"id"`
-			FirstName    string                 `json:"first_name"`
-			LastName     string                 `json:"last_name"`
-			Email        string                 `json:"email"`
-			Phone        string                 `json:"phone"`
-			CustomerCode string                 `json:"customer_code"`
-			Metadata     map[string]interface{} `json:"metadata"`
-		}{
+		Data: paystack.Customer{
 			ID:           1,
 			CustomerCode: "CUS_1234567890",
 			FirstName:    "Jane",
@@ -258,6 +211,7 @@ func TestUpdateCustomer(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, r.Method, "PUT")
 		assert.Equal(t, r.URL.Path, fmt.Sprintf("/customer/%s", customerCode))
+		assert.Equal(t, r.Header.Get("Authorization"), "Bearer sk_test_1234567890")
 
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(Response)
@@ -265,9 +219,7 @@ func TestUpdateCustomer(t *testing.T) {
 
 	defer server.Close()
 
-	config.BaseURL = server.URL
-
-	client := paystack.NewClient("sk_test_1234567890")
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
 
 	req := &paystack.UpdateCustomerRequest{
 		FirstName: "Jane",
@@ -275,7 +227,7 @@ func TestUpdateCustomer(t *testing.T) {
 		Phone:     "54481255651",
 	}
 
-	res, err := client.UpdateCustomer(customerCode, req)
+	res, err := client.UpdateCustomer(context.Background(), customerCode, req)
 	assert.Nil(t, err)
 	assert.NoError(t, err)
 	assert.Equal(t, res.Status, true)
@@ -0,0 +1,201 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockDedicatedAccount is the dedicated account returned by the mock servers below.
+var mockDedicatedAccount = paystack.DedicatedAccount{
+	ID:            253,
+	AccountName:   "KAROKART/RHODA CHURCH",
+	AccountNumber: "9930000737",
+	Assigned:      true,
+	Active:        true,
+	Currency:      paystack.CurrencyNGN,
+	Bank: paystack.DedicatedAccountBank{
+		ID:   1,
+		Name: "Wema Bank",
+		Slug: "wema-bank",
+	},
+	Customer: paystack.Customer{
+		ID:           2487001,
+		CustomerCode: "CUS_z9m2l1m4j0yxvsj",
+		Email:        "rhoda@church.com",
+	},
+	Assignment: &paystack.DedicatedAccountAssignment{
+		AssigneeID:   2487001,
+		AssigneeType: "Customer",
+		AccountType:  "PAY-WITH-TRANSFER-RECURRING",
+	},
+}
+
+func TestCreateAndAssignDedicatedAccount(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/dedicated_account":
+			assert.Equal(t, body["customer"], "CUS_z9m2l1m4j0yxvsj")
+			assert.Equal(t, body["preferred_bank"], "wema-bank")
+			json.NewEncoder(w).Encode(paystack.DedicatedAccountResponse{Status: true, Message: "NUBAN successfully created", Data: mockDedicatedAccount})
+		case "/dedicated_account/assign":
+			assert.Equal(t, body["email"], "rhoda@church.com")
+			assert.Equal(t, body["country"], "NG")
+			assert.NotContains(t, body, "bvn")
+			w.Write([]byte(`{"status":true,"message":"Assign dedicated account in progress"}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	res, err := client.CreateDedicatedAccount(context.Background(), &paystack.CreateDedicatedAccountRequest{
+		Customer:      "CUS_z9m2l1m4j0yxvsj",
+		PreferredBank: "wema-bank",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.AccountNumber, "9930000737")
+	assert.Equal(t, res.Data.Bank.Slug, "wema-bank")
+	assert.Equal(t, res.Data.Customer.CustomerCode, "CUS_z9m2l1m4j0yxvsj")
+
+	req := &paystack.AssignDedicatedAccountRequest{
+		Email:         "rhoda@church.com",
+		FirstName:     "Rhoda",
+		LastName:      "Church",
+		Phone:         "+2348100000000",
+		PreferredBank: "wema-bank",
+		Country:       "NG",
+	}
+
+	assigned, err := client.AssignDedicatedAccount(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, assigned.Message, "Assign dedicated account in progress")
+
+	req.PreferredBank = ""
+	req.Country = ""
+	_, err = client.AssignDedicatedAccount(context.Background(), req)
+	assert.Equal(t, fieldsOf(t, err), []string{"preferred_bank", "country"})
+}
+
+func TestManageDedicatedAccounts(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/dedicated_account" && r.Method == "GET":
+			assert.Equal(t, r.URL.Query().Get("active"), "true")
+			assert.Equal(t, r.URL.Query().Get("provider_slug"), "wema-bank")
+			json.NewEncoder(w).Encode(paystack.ListDedicatedAccountsResponse{
+				Status:  true,
+				Message: "Managed accounts successfully retrieved",
+				Data:    []paystack.DedicatedAccount{mockDedicatedAccount},
+			})
+		case r.URL.Path == "/dedicated_account/253" && r.Method == "GET":
+			json.NewEncoder(w).Encode(paystack.DedicatedAccountResponse{Status: true, Message: "Customer retrieved", Data: mockDedicatedAccount})
+		case r.URL.Path == "/dedicated_account/253" && r.Method == "DELETE":
+			deactivated := mockDedicatedAccount
+			deactivated.Active = false
+			json.NewEncoder(w).Encode(paystack.DedicatedAccountResponse{Status: true, Message: "Managed Account Successfully Unassigned", Data: deactivated})
+		case r.URL.Path == "/dedicated_account/requery":
+			assert.Equal(t, r.URL.Query().Get("account_number"), "9930000737")
+			assert.Equal(t, r.URL.Query().Get("provider_slug"), "wema-bank")
+			assert.Equal(t, r.URL.Query().Get("date"), "2023-05-30")
+			w.Write([]byte(`{"status":true,"message":"We are checking the status of your transfer. We will send you a notification once it is confirmed"}`))
+		case r.URL.Path == "/dedicated_account/available_providers":
+			w.Write([]byte(`{"status":true,"message":"Dedicated account providers retrieved","data":[{"provider_slug":"access-bank","bank_id":1,"bank_name":"Access Bank","id":6},{"provider_slug":"wema-bank","bank_id":20,"bank_name":"Wema Bank","id":5}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	active := true
+	list, err := client.ListDedicatedAccounts(context.Background(), &paystack.ListDedicatedAccountsRequest{Active: &active, ProviderSlug: "wema-bank"})
+	assert.NoError(t, err)
+	assert.Equal(t, len(list.Data), 1)
+
+	res, err := client.FetchDedicatedAccount(context.Background(), "253")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.Assignment.AssigneeType, "Customer")
+
+	requery, err := client.RequeryDedicatedAccount(context.Background(), &paystack.RequeryDedicatedAccountRequest{
+		AccountNumber: "9930000737",
+		ProviderSlug:  "wema-bank",
+		Date:          "2023-05-30",
+	})
+	assert.NoError(t, err)
+	assert.True(t, requery.Status)
+
+	res, err = client.DeactivateDedicatedAccount(context.Background(), "253")
+	assert.NoError(t, err)
+	assert.False(t, res.Data.Active)
+
+	providers, err := client.FetchBankProviders(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, len(providers.Data), 2)
+	assert.Equal(t, providers.Data[1].ProviderSlug, "wema-bank")
+
+	_, err = client.RequeryDedicatedAccount(context.Background(), &paystack.RequeryDedicatedAccountRequest{AccountNumber: "9930000737"})
+	assert.Equal(t, fieldsOf(t, err), []string{"provider_slug"})
+}
+
+func TestSplitDedicatedAccountTransaction(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/dedicated_account/split")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case "POST":
+			assert.Equal(t, body["customer"], "CUS_z9m2l1m4j0yxvsj")
+			assert.Equal(t, body["split_code"], "SPL_e7jnRLtzla")
+			w.Write([]byte(`{"status":true,"message":"Assigned Managed Account Successfully Created","data":{"id":253,"account_number":"9930000737","split_config":{"split_code":"SPL_e7jnRLtzla"}}}`))
+		case "DELETE":
+			assert.Equal(t, body["account_number"], "9930000737")
+			w.Write([]byte(`{"status":true,"message":"Subaccount unassigned","data":{"id":253,"account_number":"9930000737","split_config":{}}}`))
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	res, err := client.SplitDedicatedAccountTransaction(context.Background(), &paystack.SplitDedicatedAccountTransactionRequest{
+		Customer:  "CUS_z9m2l1m4j0yxvsj",
+		SplitCode: "SPL_e7jnRLtzla",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.SplitConfig, map[string]interface{}{"split_code": "SPL_e7jnRLtzla"})
+
+	res, err = client.RemoveSplit(context.Background(), "9930000737")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "Subaccount unassigned")
+
+	_, err = client.SplitDedicatedAccountTransaction(context.Background(), &paystack.SplitDedicatedAccountTransactionRequest{Customer: "CUS_z9m2l1m4j0yxvsj"})
+	assert.Equal(t, fieldsOf(t, err), []string{"split_code"})
+
+	_, err = client.RemoveSplit(context.Background(), "")
+	assert.Equal(t, fieldsOf(t, err), []string{"account_number"})
+}
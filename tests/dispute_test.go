@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockDispute is the dispute returned by the mock servers below.
+const mockDispute = `{"id":2867,"refund_amount":1002,"currency":"NGN","status":"awaiting-merchant-feedback","resolution":null,"domain":"test","category":"fraud","bin":"123456","last4":"1234",` +
+	`"transaction":{"id":5991760,"reference":"asdfghjkl","amount":39100,"currency":"NGN","status":"success"},` +
+	`"customer":{"id":147004,"email":"example@test.com","customer_code":"CUS_7u5eum2n6qxo38z"},` +
+	`"history":[{"status":"awaiting-merchant-feedback","by":"demo@test.co","createdAt":"2017-11-07T18:46:23.000Z"}],` +
+	`"messages":[{"sender":"demo@test.co","body":"test dispute","createdAt":"2017-11-07T18:46:23.000Z"}],"dueAt":null}`
+
+func TestListAndFetchDisputes(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/dispute":
+			assert.Equal(t, r.URL.Query().Get("status"), "awaiting-merchant-feedback")
+			w.Write([]byte(`{"status":true,"message":"Disputes retrieved","data":[` + mockDispute + `],"meta":{"total":1,"page":1,"pageCount":1}}`))
+		case "/dispute/2867", "/dispute/transaction/5991760":
+			w.Write([]byte(`{"status":true,"message":"Dispute retrieved","data":` + mockDispute + `}`))
+		case "/dispute/export":
+			assert.Equal(t, r.URL.Query().Get("transaction"), "5991760")
+			w.Write([]byte(`{"status":true,"message":"Export successful","data":{"path":"https://files.paystack.co/exports/disputes.csv","expiresAt":"2025-01-01 12:00:00"}}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	list, err := client.ListDisputes(context.Background(), &paystack.ListDisputesRequest{Status: paystack.DisputeStatusAwaitingMerchantFeedback})
+	assert.NoError(t, err)
+	assert.Equal(t, len(list.Data), 1)
+	assert.Equal(t, list.Data[0].RefundAmount, paystack.NewMoney(1002, paystack.CurrencyNGN))
+
+	res, err := client.FetchDispute(context.Background(), "2867")
+	assert.NoError(t, err)
+	assert.False(t, res.Data.IsResolved())
+	assert.Equal(t, res.Data.Transaction.Reference, "asdfghjkl")
+	assert.Equal(t, res.Data.Customer.CustomerCode, "CUS_7u5eum2n6qxo38z")
+	assert.Equal(t, res.Data.History[0].Status, paystack.DisputeStatusAwaitingMerchantFeedback)
+	assert.Equal(t, res.Data.Messages[0].Body, "test dispute")
+
+	res, err = client.ListTransactionDisputes(context.Background(), "5991760")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.ID, 2867)
+
+	export, err := client.ExportDisputes(context.Background(), &paystack.ExportDisputesRequest{Transaction: "5991760"})
+	assert.NoError(t, err)
+	assert.Equal(t, export.Data.Path, "https://files.paystack.co/exports/disputes.csv")
+}
+
+func TestContestDispute(t *testing.T) {
+	var uploaded string
+
+	// create a mock server
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/dispute/2867/upload_url":
+			assert.Equal(t, r.Method, "GET")
+			assert.Equal(t, r.URL.Query().Get("upload_filename"), "receipt.pdf")
+			json.NewEncoder(w).Encode(paystack.DisputeUploadURLResponse{
+				Status:  true,
+				Message: "Upload url generated",
+				Data:    paystack.DisputeUploadURL{SignedURL: server.URL + "/upload/2867-receipt.pdf?X-Amz-Signature=abc", FileName: "2867-receipt.pdf", ExpiresIn: 30},
+			})
+		case "/upload/2867-receipt.pdf":
+			// the storage provider must not receive the secret key
+			assert.Equal(t, r.Method, "PUT")
+			assert.Empty(t, r.Header.Get("Authorization"))
+			assert.Equal(t, r.ContentLength, int64(len("%PDF-1.4 receipt")))
+			body, _ := io.ReadAll(r.Body)
+			uploaded = string(body)
+		case "/dispute/2867/evidence":
+			assert.Equal(t, r.Method, "POST")
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, body["customer_email"], "cus@gmail.com")
+			assert.Equal(t, body["delivery_date"], "2020-03-12")
+			w.Write([]byte(`{"status":true,"message":"Evidence created","data":{"customer_email":"cus@gmail.com","customer_name":"Mensah King","customer_phone":"0802345167","service_details":"claim for buying product","delivery_address":"3a ladoke street ogbomoso","dispute":2867,"id":21}}`))
+		case "/dispute/2867":
+			assert.Equal(t, r.Method, "PUT")
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			// attaching a file alone must not reset the refund amount
+			assert.NotContains(t, body, "refund_amount")
+			assert.Equal(t, body["uploaded_filename"], "2867-receipt.pdf")
+			w.Write([]byte(`{"status":true,"message":"Dispute updated","data":` + mockDispute + `}`))
+		case "/dispute/2867/resolve":
+			assert.Equal(t, r.Method, "PUT")
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, body["resolution"], "declined")
+			assert.Equal(t, body["refund_amount"], float64(0))
+			assert.Equal(t, body["evidence"], float64(21))
+			w.Write([]byte(`{"status":true,"message":"Dispute successfully resolved","data":{"id":2867,"currency":"NGN","status":"resolved","resolution":"declined","refund_amount":0}}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	upload, err := client.GetDisputeUploadURL(context.Background(), "2867", "receipt.pdf")
+	assert.NoError(t, err)
+
+	err = client.UploadDisputeEvidence(context.Background(), &upload.Data, strings.NewReader("%PDF-1.4 receipt"))
+	assert.NoError(t, err)
+	assert.Equal(t, uploaded, "%PDF-1.4 receipt")
+
+	evidence, err := client.AddDisputeEvidence(context.Background(), "2867", &paystack.AddDisputeEvidenceRequest{
+		CustomerEmail:   "cus@gmail.com",
+		CustomerName:    "Mensah King",
+		CustomerPhone:   "0802345167",
+		ServiceDetails:  "claim for buying product",
+		DeliveryAddress: "3a ladoke street ogbomoso",
+		DeliveryDate:    "2020-03-12",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, evidence.Data.ID, 21)
+
+	_, err = client.UpdateDispute(context.Background(), "2867", &paystack.UpdateDisputeRequest{UploadedFilename: upload.Data.FileName})
+	assert.NoError(t, err)
+
+	res, err := client.ResolveDispute(context.Background(), "2867", &paystack.ResolveDisputeRequest{
+		Resolution:       paystack.DisputeResolutionDeclined,
+		Message:          "Item was delivered",
+		UploadedFilename: upload.Data.FileName,
+		Evidence:         evidence.Data.ID,
+	})
+	assert.NoError(t, err)
+	assert.True(t, res.Data.IsResolved())
+	assert.Equal(t, res.Data.Resolution, paystack.DisputeResolutionDeclined)
+	assert.Equal(t, res.Data.RefundAmount, paystack.NewMoney(0, paystack.CurrencyNGN))
+
+	_, err = client.ResolveDispute(context.Background(), "2867", &paystack.ResolveDisputeRequest{Resolution: "refunded"})
+	assert.Equal(t, fieldsOf(t, err), []string{"resolution", "message", "uploaded_filename"})
+
+	refund := paystack.NewMoney(-100, "EUR")
+	_, err = client.UpdateDispute(context.Background(), "2867", &paystack.UpdateDisputeRequest{RefundAmount: &refund})
+	assert.Equal(t, fieldsOf(t, err), []string{"refund_amount", "refund_amount"})
+}
+
+func TestUploadDisputeEvidenceFailure(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`<Error><Code>SignatureDoesNotMatch</Code></Error>`))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	err := client.UploadDisputeEvidence(context.Background(), &paystack.DisputeUploadURL{SignedURL: server.URL + "/upload"}, strings.NewReader("receipt"))
+	assert.ErrorContains(t, err, "status 403")
+	assert.ErrorContains(t, err, "SignatureDoesNotMatch")
+
+	// storage failures must not be mistaken for Paystack API errors
+	var apiErr *paystack.APIError
+	assert.False(t, errors.As(err, &apiErr))
+
+	err = client.UploadDisputeEvidence(context.Background(), nil, strings.NewReader("receipt"))
+	assert.ErrorContains(t, err, "missing signed URL")
+
+	err = client.UploadDisputeEvidence(context.Background(), &paystack.DisputeUploadURL{}, strings.NewReader("receipt"))
+	assert.ErrorContains(t, err, "missing signed URL")
+}
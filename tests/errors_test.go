@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError(t *testing.T) {
+	// create a mock server returning a Paystack style error body
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/transaction/verify/unknown")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_123")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status":false,"message":"Transaction reference not found","type":"validation_error","code":"transaction_not_found"}`))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	res, err := client.VerifyTransaction(context.Background(), "unknown")
+	assert.Nil(t, res)
+	assert.Error(t, err)
+	assert.True(t, paystack.IsNotFound(err))
+	assert.False(t, paystack.IsUnauthorized(err))
+	assert.False(t, paystack.IsRateLimited(err))
+
+	var apiErr *paystack.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, apiErr.StatusCode, http.StatusNotFound)
+	assert.Equal(t, apiErr.Message, "Transaction reference not found")
+	assert.Equal(t, apiErr.Type, "validation_error")
+	assert.Equal(t, apiErr.Code, "transaction_not_found")
+	assert.Equal(t, apiErr.RequestID, "req_123")
+	assert.Contains(t, string(apiErr.Body), "transaction_not_found")
+}
+
+func TestAPIErrorUnauthorized(t *testing.T) {
+	// create a mock server rejecting the secret key with a non-JSON body
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte("Invalid key"))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_bad", paystack.WithBaseURL(server.URL))
+
+	_, err := client.ListPlans(context.Background(), nil)
+	assert.True(t, paystack.IsUnauthorized(err))
+	assert.Contains(t, err.Error(), "Invalid key")
+}
+
+func TestListTransactionsAPIError(t *testing.T) {
+	// create a mock server failing the list request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"status":false,"message":"Too many requests"}`))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	res, err := client.ListTransactions(context.Background(), &paystack.ListTransactionsRequest{})
+	assert.Nil(t, res)
+	assert.True(t, paystack.IsRateLimited(err))
+}
@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterateCustomers(t *testing.T) {
+	requests := 0
+
+	// create a mock server serving three pages of two customers each
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/customer")
+		assert.Equal(t, r.URL.Query().Get("perPage"), "2")
+		requests++
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		Response := paystack.ListCustomersResponse{
+			Status:  true,
+			Message: "Customers retrieved",
+			Data: []paystack.Customer{
+				{ID: page*10 + 1, CustomerCode: fmt.Sprintf("CUS_%d_1", page)},
+				{ID: page*10 + 2, CustomerCode: fmt.Sprintf("CUS_%d_2", page)},
+			},
+			Meta: &paystack.Meta{Total: 6, PerPage: 2, Page: page, PageCount: 3},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Response)
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	it := client.IterateCustomers(context.Background(), &paystack.ListCustomersRequest{PerPage: 2})
+
+	var ids []int
+	for it.Next() {
+		ids = append(ids, it.Current().ID)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, ids, []int{11, 12, 21, 22, 31, 32})
+	assert.Equal(t, requests, 3)
+	assert.Equal(t, it.Meta().Total, 6)
+	assert.Equal(t, it.Meta().Page, 3)
+}
+
+func TestIteratePlansStopsOnEmptyPage(t *testing.T) {
+	// create a mock server that returns no meta and an empty second page
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/plan")
+
+		Response := paystack.ListPlansResponse{Status: true, Message: "Plans retrieved"}
+		if r.URL.Query().Get("page") == "1" {
+			Response.Data = []paystack.Plan{{Name: "Basic", PlanCode: "PLN_1"}}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Response)
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	it := client.IteratePlans(context.Background(), nil)
+
+	var codes []string
+	for it.Next() {
+		codes = append(codes, it.Current().PlanCode)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, codes, []string{"PLN_1"})
+}
+
+func TestIterateTransactionsContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// create a mock server that always reports a further page
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Response := paystack.ListTransactionsResponse{
+			Status:  true,
+			Message: "Transactions fetched",
+			Data:    []paystack.Transaction{{ID: 1}},
+			Meta:    &paystack.Meta{Total: 2, PerPage: 1, Page: 1, PageCount: 2},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Response)
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	it := client.IterateTransactions(ctx, &paystack.ListTransactionsRequest{PerPage: 1})
+
+	assert.True(t, it.Next())
+	assert.Equal(t, it.Current().ID, 1)
+
+	cancel()
+	assert.False(t, it.Next())
+	assert.ErrorIs(t, it.Err(), context.Canceled)
+}
@@ -0,0 +1,78 @@
+package tests
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoneyConstructors(t *testing.T) {
+	assert.Equal(t, paystack.MoneyFromMajor(1500, paystack.CurrencyNGN), paystack.NewMoney(150000, paystack.CurrencyNGN))
+
+	m, err := paystack.ParseMoney("1500.5", paystack.CurrencyGHS)
+	assert.NoError(t, err)
+	assert.Equal(t, m, paystack.NewMoney(150050, paystack.CurrencyGHS))
+
+	m, err = paystack.ParseMoney("-0.05", paystack.CurrencyUSD)
+	assert.NoError(t, err)
+	assert.Equal(t, m.Amount, int64(-5))
+
+	m, err = paystack.ParseMoney("92233720368547758.07", paystack.CurrencyNGN)
+	assert.NoError(t, err)
+	assert.Equal(t, m.Amount, int64(math.MaxInt64))
+
+	for _, input := range []string{"", "abc", "1.234", "1.", "1.-5", "--1", "1.+5", "+5",
+		"922337203685477581", "92233720368547758.08", "-92233720368547758.09"} {
+		_, err := paystack.ParseMoney(input, paystack.CurrencyNGN)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestMoneyFormattingAndArithmetic(t *testing.T) {
+	m := paystack.NewMoney(150050, paystack.CurrencyNGN)
+	assert.Equal(t, m.Major(), "1500.50")
+	assert.Equal(t, m.String(), "NGN 1500.50")
+	assert.Equal(t, paystack.NewMoney(-5, "").String(), "-0.05")
+
+	sum, err := m.Add(paystack.NewMoney(50, paystack.CurrencyNGN))
+	assert.NoError(t, err)
+	assert.Equal(t, sum, paystack.NewMoney(150100, paystack.CurrencyNGN))
+
+	diff, err := m.Sub(paystack.NewMoney(50, ""))
+	assert.NoError(t, err)
+	assert.Equal(t, diff, paystack.NewMoney(150000, paystack.CurrencyNGN))
+
+	_, err = m.Add(paystack.NewMoney(50, paystack.CurrencyUSD))
+	assert.Error(t, err)
+
+	assert.Equal(t, m.Mul(3), paystack.NewMoney(450150, paystack.CurrencyNGN))
+	assert.True(t, paystack.Money{}.IsZero())
+	assert.True(t, paystack.CurrencyXOF.IsSupported())
+	assert.False(t, paystack.Currency("EUR").IsSupported())
+}
+
+func TestMoneyJSON(t *testing.T) {
+	data, err := json.Marshal(paystack.NewMoney(10000, paystack.CurrencyNGN))
+	assert.NoError(t, err)
+	assert.Equal(t, string(data), "10000")
+
+	var m paystack.Money
+	assert.NoError(t, json.Unmarshal([]byte(`"2500"`), &m))
+	assert.Equal(t, m.Amount, int64(2500))
+	assert.Error(t, json.Unmarshal([]byte(`12.5`), &m))
+
+	var tx paystack.Transaction
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount":10000,"currency":"GHS"}`), &tx))
+	assert.Equal(t, tx.Amount, paystack.NewMoney(10000, paystack.CurrencyGHS))
+
+	data, err = json.Marshal(&paystack.CreatePlanRequest{
+		Name:     "Basic",
+		Amount:   paystack.MoneyFromMajor(50, paystack.CurrencyKES),
+		Interval: "monthly",
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(data), `{"name":"Basic","amount":5000,"interval":"monthly","currency":"KES"}`)
+}
@@ -1,12 +1,12 @@
 package tests
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
-	"github.com/aglili/gopaystack/config"
 	"github.com/aglili/gopaystack/paystack"
 	"github.com/stretchr/testify/assert"
 )
@@ -17,10 +17,13 @@ func TestCreatePlan(t *testing.T) {
 	Response := paystack.PlanResponse{
 		Status:  true,
 		Message: "Plan created",
-		Data: paystack.CreatePlanRequest{
+		Data: paystack.Plan{
+			ID:           28,
 			Name:         "Basic",
-			Amount:       10000,
-			Interval:     "monthly",
+			PlanCode:     "PLN_gx2wn530m0i3w3m",
+			Amount:       paystack.NewMoney(10000, paystack.CurrencyNGN),
+			Interval:     paystack.PlanIntervalMonthly,
+			Currency:     paystack.CurrencyNGN,
 			Description:  "Basic plan",
 			SendInvoices: true,
 		},
@@ -37,26 +40,25 @@ func TestCreatePlan(t *testing.T) {
 
 	defer server.Close()
 
-	config.BaseURL = server.URL
-
-	client := paystack.NewClient("sk_test_1234567890")
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
 
 	req := &paystack.CreatePlanRequest{
 		Name:         "Basic",
-		Amount:       10000,
-		Interval:     "monthly",
+		Amount:       paystack.NewMoney(10000, paystack.CurrencyNGN),
+		Interval:     paystack.PlanIntervalMonthly,
 		Description:  "Basic plan",
 		SendInvoices: true,
 	}
 
-	res, err := client.CreatePlan(req)
+	res, err := client.CreatePlan(context.Background(), req)
 	assert.Nil(t, err)
 	assert.Equal(t, res.Status, true)
 	assert.Equal(t, res.Message, "Plan created")
 	assert.Equal(t, res.Data.Name, "Basic")
-	assert.Equal(t, res.Data.Amount, 10000)
-	assert.Equal(t, res.Data.Interval, "monthly")
+	assert.Equal(t, res.Data.Amount, paystack.NewMoney(10000, paystack.CurrencyNGN))
+	assert.Equal(t, res.Data.Interval, paystack.PlanIntervalMonthly)
 	assert.Equal(t, res.Data.Description, "Basic plan")
+	assert.Equal(t, res.Data.PlanCode, "PLN_gx2wn530m0i3w3m")
 }
 
 func TestListPlans(t *testing.T) {
@@ -65,21 +67,20 @@ func TestListPlans(t *testing.T) {
 	Response := paystack.ListPlansResponse{
 		Status:  true,
 		Message: "Plans retrieved",
-		Data: []struct {
-			Name        string `json:"name"`
-			Amount      int    `json:"amount"`
-			Description string `json:"description"`
-			PlanCode    string `json:"plan_code"`
-		}{
+		Data: []paystack.Plan{
 			{
-				Name:        "Basic",
-				Amount:      10000,
-				Description: "Basic plan",
-				PlanCode:    "PLN_1234567890",
+				Name:                "Basic",
+				Amount:              paystack.NewMoney(10000, paystack.CurrencyNGN),
+				Currency:            paystack.CurrencyNGN,
+				Description:         "Basic plan",
+				PlanCode:            "PLN_1234567890",
+				TotalSubscriptions:  3,
+				ActiveSubscriptions: 2,
 			},
 			{
 				Name:        "Pro",
-				Amount:      20000,
+				Amount:      paystack.NewMoney(20000, paystack.CurrencyNGN),
+				Currency:    paystack.CurrencyNGN,
 				Description: "Pro plan",
 				PlanCode:    "PLN_0987654321",
 			},
@@ -97,17 +98,103 @@ func TestListPlans(t *testing.T) {
 
 	defer server.Close()
 
-	config.BaseURL = server.URL
-
-	client := paystack.NewClient("sk_test_1234567890")
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
 
-	res, err := client.ListPlans()
+	res, err := client.ListPlans(context.Background(), nil)
 
 	assert.Nil(t, err)
 	assert.Equal(t, res.Status, true)
 	assert.Equal(t, res.Message, "Plans retrieved")
 	assert.Equal(t, len(res.Data), 2)
 	assert.Equal(t, res.Data[0].Name, "Basic")
-	assert.Equal(t, res.Data[0].Amount, 10000)
+	assert.Equal(t, res.Data[0].Amount.Amount, int64(10000))
 	assert.Equal(t, res.Data[0].Description, "Basic plan")
+	assert.Equal(t, res.Data[0].ActiveSubscriptions, 2)
+}
+
+func TestListPlansFilters(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Query().Get("status"), "active")
+		assert.Equal(t, r.URL.Query().Get("interval"), "monthly")
+		assert.Equal(t, r.URL.Query().Get("amount"), "500000")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":true,"message":"Plans retrieved","data":[]}`))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	req := &paystack.ListPlansRequest{
+		Status:   "active",
+		Interval: paystack.PlanIntervalMonthly,
+		Amount:   500000,
+	}
+
+	res, err := client.ListPlans(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, len(res.Data), 0)
+
+	req.Interval = "fortnightly"
+	_, err = client.ListPlans(context.Background(), req)
+	assert.Equal(t, fieldsOf(t, err), []string{"interval"})
+}
+
+func TestFetchPlan(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/plan/PLN_gx2wn530m0i3w3m")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":true,"message":"Plan retrieved","data":{"id":28,"name":"Monthly retainer","plan_code":"PLN_gx2wn530m0i3w3m","amount":50000,"interval":"monthly","currency":"GHS","invoice_limit":12}}`))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	res, err := client.FetchPlan(context.Background(), "PLN_gx2wn530m0i3w3m")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.ID, 28)
+	assert.Equal(t, res.Data.Amount, paystack.NewMoney(50000, paystack.CurrencyGHS))
+	assert.Equal(t, res.Data.Interval, paystack.PlanIntervalMonthly)
+	assert.Equal(t, res.Data.InvoiceLimit, 12)
+}
+
+func TestUpdatePlan(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/plan/PLN_gx2wn530m0i3w3m")
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, body["amount"], float64(60000))
+		assert.Equal(t, body["currency"], "NGN")
+		assert.Equal(t, body["update_existing_subscriptions"], false)
+		assert.NotContains(t, body, "name")
+		assert.NotContains(t, body, "send_invoices")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":true,"message":"Plan updated. 0 subscription(s) affected"}`))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	amount := paystack.NewMoney(60000, paystack.CurrencyNGN)
+	existing := false
+	req := &paystack.UpdatePlanRequest{
+		Amount:                      &amount,
+		UpdateExistingSubscriptions: &existing,
+	}
+
+	res, err := client.UpdatePlan(context.Background(), "PLN_gx2wn530m0i3w3m", req)
+	assert.NoError(t, err)
+	assert.True(t, res.Status)
+	assert.Equal(t, res.Message, "Plan updated. 0 subscription(s) affected")
 }
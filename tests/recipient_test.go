@@ -0,0 +1,171 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockRecipient is the transfer recipient returned by the mock servers below.
+var mockRecipient = paystack.TransferRecipient{
+	ID:            6788170,
+	Type:          paystack.RecipientTypeNUBAN,
+	Currency:      paystack.CurrencyNGN,
+	Name:          "Tolu Robert",
+	RecipientCode: "RCP_t0ya41mp35flk40",
+	Active:        true,
+	Details: paystack.RecipientDetails{
+		AccountNumber: "01000000010",
+		AccountName:   "Tolu Robert",
+		BankCode:      "058",
+		BankName:      "Guaranty Trust Bank",
+	},
+}
+
+func TestCreateTransferRecipient(t *testing.T) {
+	// mock the response
+	Response := paystack.TransferRecipientResponse{
+		Status:  true,
+		Message: "Transfer recipient created successfully",
+		Data:    mockRecipient,
+	}
+
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/transferrecipient")
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, body["type"], "nuban")
+		assert.Equal(t, body["bank_code"], "058")
+		assert.NotContains(t, body, "authorization_code")
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Response)
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	req := &paystack.CreateTransferRecipientRequest{
+		Type:          paystack.RecipientTypeNUBAN,
+		Name:          "Tolu Robert",
+		AccountNumber: "01000000010",
+		BankCode:      "058",
+		Currency:      paystack.CurrencyNGN,
+	}
+
+	res, err := client.CreateTransferRecipient(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.RecipientCode, "RCP_t0ya41mp35flk40")
+	assert.Equal(t, res.Data.Type, paystack.RecipientTypeNUBAN)
+	assert.Equal(t, res.Data.Details.BankName, "Guaranty Trust Bank")
+
+	req.Type = paystack.RecipientTypeAuthorization
+	_, err = client.CreateTransferRecipient(context.Background(), req)
+	assert.Equal(t, fieldsOf(t, err), []string{"authorization_code", "email"})
+
+	req.Type = "wallet"
+	_, err = client.CreateTransferRecipient(context.Background(), req)
+	assert.Equal(t, fieldsOf(t, err), []string{"type"})
+}
+
+func TestBulkCreateTransferRecipients(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/transferrecipient/bulk")
+
+		var body paystack.BulkCreateTransferRecipientsRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, len(body.Batch), 2)
+		assert.Equal(t, body.Batch[1].Type, paystack.RecipientTypeMobileMoney)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(paystack.BulkTransferRecipientsResponse{
+			Status:  true,
+			Message: "Recipients added successfully",
+			Data: paystack.BulkTransferRecipients{
+				Success: []paystack.TransferRecipient{mockRecipient},
+				Errors:  []interface{}{},
+			},
+		})
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	req := &paystack.BulkCreateTransferRecipientsRequest{
+		Batch: []paystack.CreateTransferRecipientRequest{
+			{Type: paystack.RecipientTypeNUBAN, Name: "Tolu Robert", AccountNumber: "01000000010", BankCode: "058"},
+			{Type: paystack.RecipientTypeMobileMoney, Name: "Kofi Mensah", AccountNumber: "0551234987", BankCode: "MTN", Currency: paystack.CurrencyGHS},
+		},
+	}
+
+	res, err := client.BulkCreateTransferRecipients(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, len(res.Data.Success), 1)
+	assert.Equal(t, len(res.Data.Errors), 0)
+
+	req.Batch[1].BankCode = ""
+	_, err = client.BulkCreateTransferRecipients(context.Background(), req)
+	assert.Equal(t, fieldsOf(t, err), []string{"batch[1].bank_code"})
+}
+
+func TestManageTransferRecipients(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/transferrecipient":
+			assert.Equal(t, r.Method, "GET")
+			assert.Equal(t, r.URL.Query().Get("perPage"), "50")
+			json.NewEncoder(w).Encode(paystack.ListTransferRecipientsResponse{
+				Status:  true,
+				Message: "Recipients retrieved",
+				Data:    []paystack.TransferRecipient{mockRecipient},
+				Meta:    &paystack.Meta{Total: 1, Page: 1, PageCount: 1},
+			})
+		case r.URL.Path == "/transferrecipient/RCP_t0ya41mp35flk40" && r.Method == "GET":
+			json.NewEncoder(w).Encode(paystack.TransferRecipientResponse{Status: true, Message: "Recipient retrieved", Data: mockRecipient})
+		case r.URL.Path == "/transferrecipient/RCP_t0ya41mp35flk40" && r.Method == "PUT":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, body["name"], "Rick Sanchez")
+			w.Write([]byte(`{"status":true,"message":"Recipient updated"}`))
+		case r.URL.Path == "/transferrecipient/RCP_t0ya41mp35flk40" && r.Method == "DELETE":
+			w.Write([]byte(`{"status":true,"message":"Transfer recipient set as inactive"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	list, err := client.ListTransferRecipients(context.Background(), &paystack.ListTransferRecipientsRequest{PerPage: 50})
+	assert.NoError(t, err)
+	assert.Equal(t, len(list.Data), 1)
+
+	res, err := client.FetchTransferRecipient(context.Background(), "RCP_t0ya41mp35flk40")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.ID, 6788170)
+
+	updated, err := client.UpdateTransferRecipient(context.Background(), "RCP_t0ya41mp35flk40", &paystack.UpdateTransferRecipientRequest{Name: "Rick Sanchez"})
+	assert.NoError(t, err)
+	assert.Equal(t, updated.Message, "Recipient updated")
+
+	deleted, err := client.DeleteTransferRecipient(context.Background(), "RCP_t0ya41mp35flk40")
+	assert.NoError(t, err)
+	assert.Equal(t, deleted.Message, "Transfer recipient set as inactive")
+}
@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateRefund(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/refund")
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, body["transaction"], "T685312322670591")
+		assert.Equal(t, body["amount"], float64(5000))
+		assert.Equal(t, body["currency"], "NGN")
+		assert.Equal(t, body["merchant_note"], "Damaged item")
+		assert.NotContains(t, body, "customer_note")
+
+		// the create endpoint returns the full transaction
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":true,"message":"Refund has been queued for processing","data":{"transaction":{"id":1004723697,"reference":"T685312322670591","amount":10000,"currency":"NGN","status":"reversed"},"integration":412829,"deducted_amount":0,"channel":null,"merchant_note":"Damaged item","customer_note":"Refund for transaction T685312322670591","status":"pending","refunded_by":"admin@example.com","expected_at":"2020-10-13T20:58:32.540Z","currency":"NGN","domain":"live","amount":5000,"fully_deducted":false,"id":1209}}`))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	amount := paystack.NewMoney(5000, paystack.CurrencyNGN)
+	req := &paystack.CreateRefundRequest{
+		Transaction:  "T685312322670591",
+		Amount:       &amount,
+		MerchantNote: "Damaged item",
+	}
+
+	res, err := client.CreateRefund(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.ID, 1209)
+	assert.Equal(t, res.Data.Status, paystack.RefundStatusPending)
+	assert.False(t, res.Data.IsProcessed())
+	assert.Equal(t, res.Data.Amount, paystack.NewMoney(5000, paystack.CurrencyNGN))
+	assert.Equal(t, res.Data.Transaction.Reference, "T685312322670591")
+	assert.Equal(t, res.Data.Transaction.Status, paystack.TransactionStatusReversed)
+
+	req.Currency = paystack.CurrencyGHS
+	_, err = client.CreateRefund(context.Background(), req)
+	assert.Equal(t, fieldsOf(t, err), []string{"currency"})
+}
+
+func TestCreateFullRefund(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, body, map[string]interface{}{"transaction": "1004723697"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":true,"message":"Refund has been queued for processing","data":{"transaction":{"id":1004723697},"amount":10000,"currency":"NGN","status":"pending","id":1210}}`))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	res, err := client.CreateRefund(context.Background(), &paystack.CreateRefundRequest{Transaction: "1004723697"})
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.Amount.Amount, int64(10000))
+}
+
+func TestListAndFetchRefunds(t *testing.T) {
+	refund := `{"id":1209,"integration":412829,"domain":"live","transaction":1004723697,"amount":10000,"deducted_amount":10000,"fully_deducted":true,"currency":"NGN","channel":"card","status":"processed","refunded_at":"2020-10-14T09:21:18.000Z"}`
+
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/refund":
+			assert.Equal(t, r.URL.Query().Get("transaction"), "1004723697")
+			assert.Equal(t, r.URL.Query().Get("currency"), "NGN")
+			w.Write([]byte(`{"status":true,"message":"Refunds retrieved","data":[` + refund + `],"meta":{"total":1,"page":1,"pageCount":1}}`))
+		case "/refund/1209":
+			w.Write([]byte(`{"status":true,"message":"Refund retrieved","data":` + refund + `}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	list, err := client.ListRefunds(context.Background(), &paystack.ListRefundsRequest{
+		Transaction: "1004723697",
+		Currency:    paystack.CurrencyNGN,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, len(list.Data), 1)
+	assert.Equal(t, list.Data[0].Transaction.ID, 1004723697)
+
+	res, err := client.FetchRefund(context.Background(), "1209")
+	assert.NoError(t, err)
+	assert.True(t, res.Data.IsProcessed())
+	assert.True(t, res.Data.FullyDeducted)
+	assert.Equal(t, res.Data.DeductedAmount, paystack.NewMoney(10000, paystack.CurrencyNGN))
+}
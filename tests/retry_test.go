@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+var testRetryPolicy = paystack.RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     5 * time.Millisecond,
+	Jitter:         0.5,
+}
+
+// newFlakyServer returns a server that fails the first `failures` requests
+// with the given status before answering with a successful transaction.
+func newFlakyServer(t *testing.T, failures, status int, attempts *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*attempts++
+
+		if r.Method == "POST" {
+			body, _ := io.ReadAll(r.Body)
+			assert.Contains(t, string(body), `"reference":"9k2f3k4"`)
+		}
+
+		if *attempts <= failures {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(status)
+			w.Write([]byte(`{"status":false,"message":"Try again"}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(paystack.VerifyTransactionResponse{
+			Status:  true,
+			Message: "Transaction fetched",
+			Data:    paystack.Transaction{Reference: "9k2f3k4"},
+		})
+	}))
+}
+
+func TestRetryIdempotentRequest(t *testing.T) {
+	attempts := 0
+	server := newFlakyServer(t, 2, http.StatusServiceUnavailable, &attempts)
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890",
+		paystack.WithBaseURL(server.URL),
+		paystack.WithRetryPolicy(testRetryPolicy),
+	)
+
+	res, err := client.VerifyTransaction(context.Background(), "9k2f3k4")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.Reference, "9k2f3k4")
+	assert.Equal(t, attempts, 3)
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	attempts := 0
+	server := newFlakyServer(t, 5, http.StatusTooManyRequests, &attempts)
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890",
+		paystack.WithBaseURL(server.URL),
+		paystack.WithRetryPolicy(testRetryPolicy),
+	)
+
+	_, err := client.FetchTransaction(context.Background(), "9k2f3k4")
+	assert.True(t, paystack.IsRateLimited(err))
+	assert.Equal(t, attempts, 3)
+}
+
+func TestRetrySkipsNonRetryableStatus(t *testing.T) {
+	attempts := 0
+	server := newFlakyServer(t, 1, http.StatusBadRequest, &attempts)
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890",
+		paystack.WithBaseURL(server.URL),
+		paystack.WithRetryPolicy(testRetryPolicy),
+	)
+
+	_, err := client.VerifyTransaction(context.Background(), "9k2f3k4")
+	assert.True(t, paystack.IsValidationError(err))
+	assert.Equal(t, attempts, 1)
+}
+
+func TestRetryPostRequiresOptIn(t *testing.T) {
+	attempts := 0
+	server := newFlakyServer(t, 1, http.StatusBadGateway, &attempts)
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890",
+		paystack.WithBaseURL(server.URL),
+		paystack.WithRetryPolicy(testRetryPolicy),
+	)
+
+	req := &paystack.InitializeTransactionRequest{
+		Reference: "9k2f3k4",
+		Amount:    paystack.NewMoney(10000, paystack.CurrencyNGN),
+		Email:     "test@test.com",
+	}
+
+	_, err := client.InitializeTransaction(context.Background(), req)
+	assert.Error(t, err)
+	assert.Equal(t, attempts, 1)
+
+	attempts = 0
+	_, err = client.InitializeTransaction(paystack.RetryableContext(context.Background()), req)
+	assert.NoError(t, err)
+	assert.Equal(t, attempts, 2)
+}
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestRetryTransportError(t *testing.T) {
+	attempts := 0
+	client := paystack.NewClient("sk_test_1234567890",
+		paystack.WithHTTPClient(&http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			attempts++
+			return nil, errors.New("connection reset by peer")
+		})}),
+		paystack.WithRetryPolicy(testRetryPolicy),
+	)
+
+	_, err := client.VerifyTransaction(context.Background(), "9k2f3k4")
+	assert.ErrorContains(t, err, "error making request")
+	assert.Equal(t, attempts, 3)
+}
+
+func TestRetrySkipsRequestConstructionError(t *testing.T) {
+	// a malformed base URL fails the same way on every attempt, so the
+	// client must give up straight away rather than back off
+	policy := testRetryPolicy
+	policy.InitialBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+
+	client := paystack.NewClient("sk_test_1234567890",
+		paystack.WithBaseURL("http://[::1"),
+		paystack.WithRetryPolicy(policy),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := client.VerifyTransaction(ctx, "9k2f3k4")
+	assert.ErrorContains(t, err, "error creating request")
+	assert.NotErrorIs(t, err, context.DeadlineExceeded)
+}
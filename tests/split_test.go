@@ -0,0 +1,246 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockSplit is the split returned by the mock servers below.
+var mockSplit = paystack.Split{
+	ID:         143,
+	Name:       "Halfsies",
+	Type:       paystack.SplitTypePercentage,
+	Currency:   paystack.CurrencyNGN,
+	SplitCode:  "SPL_e7jnRLtzla",
+	Active:     true,
+	BearerType: paystack.SplitBearerSubaccount,
+	Subaccounts: []paystack.SplitSubaccountShare{
+		{
+			Subaccount: paystack.Subaccount{
+				ID:             52,
+				SubaccountCode: "ACCT_eg4sob4590pq9vb",
+				BusinessName:   "Oasis Global",
+			},
+			Share: 20,
+		},
+	},
+	TotalSubaccounts: 1,
+}
+
+func TestCreateSplit(t *testing.T) {
+	// mock the response
+	Response := paystack.SplitResponse{
+		Status:  true,
+		Message: "Split created",
+		Data:    mockSplit,
+	}
+
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/split")
+
+		var body paystack.CreateSplitRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, body.Name, "Halfsies")
+		assert.Equal(t, body.Subaccounts[0].Subaccount, "ACCT_eg4sob4590pq9vb")
+		assert.Equal(t, body.BearerSubaccount, "ACCT_eg4sob4590pq9vb")
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Response)
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	req := &paystack.CreateSplitRequest{
+		Name:     "Halfsies",
+		Type:     paystack.SplitTypePercentage,
+		Currency: paystack.CurrencyNGN,
+		Subaccounts: []paystack.SplitSubaccount{
+			{Subaccount: "ACCT_eg4sob4590pq9vb", Share: 20},
+		},
+		BearerType:       paystack.SplitBearerSubaccount,
+		BearerSubaccount: "ACCT_eg4sob4590pq9vb",
+	}
+
+	res, err := client.CreateSplit(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "Split created")
+	assert.Equal(t, res.Data.SplitCode, "SPL_e7jnRLtzla")
+	assert.Equal(t, res.Data.Subaccounts[0].Subaccount.SubaccountCode, "ACCT_eg4sob4590pq9vb")
+	assert.Equal(t, res.Data.Subaccounts[0].Share, 20)
+
+	req.Subaccounts[0].Share = 120
+	req.BearerSubaccount = ""
+	_, err = client.CreateSplit(context.Background(), req)
+	assert.Equal(t, fieldsOf(t, err), []string{"subaccounts", "bearer_subaccount"})
+}
+
+func TestListAndFetchSplits(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/split":
+			assert.Equal(t, r.URL.Query().Get("active"), "true")
+			assert.Equal(t, r.URL.Query().Get("name"), "Halfsies")
+			json.NewEncoder(w).Encode(paystack.ListSplitsResponse{
+				Status:  true,
+				Message: "Split retrieved",
+				Data:    []paystack.Split{mockSplit},
+				Meta:    &paystack.Meta{Total: 1, Page: 1, PageCount: 1},
+			})
+		case "/split/143":
+			json.NewEncoder(w).Encode(paystack.SplitResponse{Status: true, Message: "Split retrieved", Data: mockSplit})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	active := true
+	list, err := client.ListSplits(context.Background(), &paystack.ListSplitsRequest{Name: "Halfsies", Active: &active})
+	assert.NoError(t, err)
+	assert.Equal(t, len(list.Data), 1)
+	assert.Equal(t, list.Meta.Total, 1)
+
+	res, err := client.FetchSplit(context.Background(), "143")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.ID, 143)
+	assert.Equal(t, res.Data.Name, "Halfsies")
+}
+
+func TestUpdateSplitSubaccounts(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/split/143":
+			assert.Equal(t, r.Method, "PUT")
+			assert.Equal(t, body["active"], false)
+			assert.Equal(t, body["bearer_type"], "all")
+			json.NewEncoder(w).Encode(paystack.SplitResponse{Status: true, Message: "Split group updated", Data: mockSplit})
+		case "/split/143/subaccount/add":
+			assert.Equal(t, r.Method, "POST")
+			assert.Equal(t, body["subaccount"], "ACCT_hdl8abxl8drhrl3")
+			assert.Equal(t, body["share"], float64(15))
+			json.NewEncoder(w).Encode(paystack.SplitResponse{Status: true, Message: "Subaccount added", Data: mockSplit})
+		case "/split/143/subaccount/remove":
+			assert.Equal(t, r.Method, "POST")
+			assert.Equal(t, body["subaccount"], "ACCT_hdl8abxl8drhrl3")
+			w.Write([]byte(`{"status":true,"message":"Subaccount removed"}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	inactive := false
+	res, err := client.UpdateSplit(context.Background(), "143", &paystack.UpdateSplitRequest{
+		Active:     &inactive,
+		BearerType: paystack.SplitBearerAll,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "Split group updated")
+
+	res, err = client.AddOrUpdateSplitSubaccount(context.Background(), "143", &paystack.SplitSubaccount{
+		Subaccount: "ACCT_hdl8abxl8drhrl3",
+		Share:      15,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "Subaccount added")
+
+	removed, err := client.RemoveSplitSubaccount(context.Background(), "143", "ACCT_hdl8abxl8drhrl3")
+	assert.NoError(t, err)
+	assert.True(t, removed.Status)
+	assert.Equal(t, removed.Message, "Subaccount removed")
+
+	_, err = client.RemoveSplitSubaccount(context.Background(), "143", "")
+	assert.Equal(t, fieldsOf(t, err), []string{"subaccount"})
+}
+
+func TestInitializeTransactionWithSplit(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, body["split_code"], "SPL_e7jnRLtzla")
+		assert.NotContains(t, body, "split")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":true,"message":"Authorization URL created","data":{"reference":"9k2f3k4"}}`))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	req := &paystack.InitializeTransactionRequest{
+		Email:     "test@test.com",
+		Amount:    paystack.NewMoney(20000, paystack.CurrencyNGN),
+		SplitCode: "SPL_e7jnRLtzla",
+	}
+
+	res, err := client.InitializeTransaction(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.Reference, "9k2f3k4")
+
+	req.Split = &paystack.DynamicSplit{Type: paystack.SplitTypeFlat, BearerType: paystack.SplitBearerAccount}
+	_, err = client.InitializeTransaction(context.Background(), req)
+	assert.Equal(t, fieldsOf(t, err), []string{"split", "split.subaccounts"})
+}
+
+func TestIterateSplits(t *testing.T) {
+	// create a mock server serving two pages
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/split")
+		assert.Equal(t, r.URL.Query().Get("perPage"), "1")
+
+		split := mockSplit
+		page := 1
+		if r.URL.Query().Get("page") == "2" {
+			split.ID = 144
+			page = 2
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(paystack.ListSplitsResponse{
+			Status:  true,
+			Message: "Split retrieved",
+			Data:    []paystack.Split{split},
+			Meta:    &paystack.Meta{Total: 2, PerPage: 1, Page: page, PageCount: 2},
+		})
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	var ids []int
+	it := client.IterateSplits(context.Background(), &paystack.ListSplitsRequest{PerPage: 1})
+	for it.Next() {
+		ids = append(ids, it.Current().ID)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, ids, []int{143, 144})
+}
@@ -0,0 +1,256 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockSubaccount is the subaccount returned by the mock servers below.
+var mockSubaccount = paystack.Subaccount{
+	ID:                 55,
+	SubaccountCode:     "ACCT_6uujpqtzmnufzkw",
+	BusinessName:       "Oasis Global",
+	Description:        "Oasis",
+	PercentageCharge:   18.2,
+	SettlementBank:     "Access Bank",
+	AccountNumber:      "0123456047",
+	Currency:           paystack.CurrencyNGN,
+	SettlementSchedule: paystack.SettlementScheduleAuto,
+	Active:             true,
+}
+
+func TestCreateSubaccount(t *testing.T) {
+	// mock the response
+	Response := paystack.SubaccountResponse{
+		Status:  true,
+		Message: "Subaccount created",
+		Data:    mockSubaccount,
+	}
+
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/subaccount")
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, body["business_name"], "Oasis Global")
+		assert.Equal(t, body["settlement_bank"], "044")
+		assert.Equal(t, body["percentage_charge"], 18.2)
+		assert.NotContains(t, body, "description")
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Response)
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	req := &paystack.CreateSubaccountRequest{
+		BusinessName:     "Oasis Global",
+		SettlementBank:   "044",
+		AccountNumber:    "0123456047",
+		PercentageCharge: 18.2,
+	}
+
+	res, err := client.CreateSubaccount(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "Subaccount created")
+	assert.Equal(t, res.Data.SubaccountCode, "ACCT_6uujpqtzmnufzkw")
+	assert.Equal(t, res.Data.PercentageCharge, 18.2)
+	assert.Equal(t, res.Data.SettlementSchedule, paystack.SettlementScheduleAuto)
+
+	req.PercentageCharge = 120
+	_, err = client.CreateSubaccount(context.Background(), req)
+	assert.Equal(t, fieldsOf(t, err), []string{"percentage_charge"})
+}
+
+func TestListAndFetchSubaccounts(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/subaccount":
+			assert.Equal(t, r.URL.Query().Get("perPage"), "20")
+			json.NewEncoder(w).Encode(paystack.ListSubaccountsResponse{
+				Status:  true,
+				Message: "Subaccounts retrieved",
+				Data:    []paystack.Subaccount{mockSubaccount},
+				Meta:    &paystack.Meta{Total: 1, Page: 1, PageCount: 1},
+			})
+		case "/subaccount/ACCT_6uujpqtzmnufzkw":
+			json.NewEncoder(w).Encode(paystack.SubaccountResponse{Status: true, Message: "Subaccount retrieved", Data: mockSubaccount})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	list, err := client.ListSubaccounts(context.Background(), &paystack.ListSubaccountsRequest{PerPage: 20})
+	assert.NoError(t, err)
+	assert.Equal(t, len(list.Data), 1)
+	assert.Equal(t, list.Meta.Total, 1)
+
+	res, err := client.FetchSubaccount(context.Background(), "ACCT_6uujpqtzmnufzkw")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.ID, 55)
+	assert.Equal(t, res.Data.BusinessName, "Oasis Global")
+}
+
+func TestUpdateSubaccount(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "PUT")
+		assert.Equal(t, r.URL.Path, "/subaccount/ACCT_6uujpqtzmnufzkw")
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, body["active"], false)
+		assert.Equal(t, body["percentage_charge"], float64(0))
+		assert.Equal(t, body["settlement_schedule"], "weekly")
+		assert.NotContains(t, body, "business_name")
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(paystack.SubaccountResponse{Status: true, Message: "Subaccount updated", Data: mockSubaccount})
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	inactive := false
+	charge := 0.0
+	req := &paystack.UpdateSubaccountRequest{
+		Active:             &inactive,
+		PercentageCharge:   &charge,
+		SettlementSchedule: paystack.SettlementScheduleWeekly,
+	}
+
+	res, err := client.UpdateSubaccount(context.Background(), "ACCT_6uujpqtzmnufzkw", req)
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "Subaccount updated")
+
+	req.SettlementSchedule = "daily"
+	_, err = client.UpdateSubaccount(context.Background(), "ACCT_6uujpqtzmnufzkw", req)
+	assert.Equal(t, fieldsOf(t, err), []string{"settlement_schedule"})
+}
+
+func TestUpdateFetchedSettlementSchedule(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			// Paystack returns the schedule upper-cased
+			w.Write([]byte(`{"status":true,"message":"Subaccount retrieved","data":{"id":55,"subaccount_code":"ACCT_6uujpqtzmnufzkw","settlement_schedule":"AUTO"}}`))
+		case "PUT":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, body["settlement_schedule"], "auto")
+			json.NewEncoder(w).Encode(paystack.SubaccountResponse{Status: true, Message: "Subaccount updated", Data: mockSubaccount})
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	fetched, err := client.FetchSubaccount(context.Background(), "ACCT_6uujpqtzmnufzkw")
+	assert.NoError(t, err)
+	assert.Equal(t, fetched.Data.SettlementSchedule, paystack.SettlementScheduleAuto)
+
+	_, err = client.UpdateSubaccount(context.Background(), "ACCT_6uujpqtzmnufzkw", &paystack.UpdateSubaccountRequest{
+		SettlementSchedule: fetched.Data.SettlementSchedule,
+	})
+	assert.NoError(t, err)
+	assert.True(t, paystack.SettlementSchedule("WEEKLY").IsValid())
+}
+
+func TestInitializeTransactionWithSubaccount(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, body["subaccount"], "ACCT_6uujpqtzmnufzkw")
+		assert.Equal(t, body["transaction_charge"], float64(1000))
+		assert.Equal(t, body["bearer"], "subaccount")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":true,"message":"Authorization URL created","data":{"reference":"7PVGX8MEk85tgeEpVDtD"}}`))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	charge := paystack.NewMoney(1000, paystack.CurrencyNGN)
+	req := &paystack.InitializeTransactionRequest{
+		Email:             "test@test.com",
+		Amount:            paystack.NewMoney(20000, paystack.CurrencyNGN),
+		Subaccount:        "ACCT_6uujpqtzmnufzkw",
+		TransactionCharge: &charge,
+		Bearer:            paystack.ChargeBearerSubaccount,
+	}
+
+	res, err := client.InitializeTransaction(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.Reference, "7PVGX8MEk85tgeEpVDtD")
+
+	req.Subaccount = ""
+	_, err = client.InitializeTransaction(context.Background(), req)
+	assert.Equal(t, fieldsOf(t, err), []string{"subaccount"})
+
+	charge.Currency = paystack.CurrencyGHS
+	req.Subaccount = "ACCT_6uujpqtzmnufzkw"
+	_, err = client.InitializeTransaction(context.Background(), req)
+	assert.Equal(t, fieldsOf(t, err), []string{"transaction_charge"})
+}
+
+func TestIterateSubaccounts(t *testing.T) {
+	// create a mock server serving two pages
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/subaccount")
+		assert.Equal(t, r.URL.Query().Get("perPage"), "1")
+
+		subaccount := mockSubaccount
+		page := 1
+		if r.URL.Query().Get("page") == "2" {
+			subaccount.ID = 56
+			page = 2
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(paystack.ListSubaccountsResponse{
+			Status:  true,
+			Message: "Subaccounts retrieved",
+			Data:    []paystack.Subaccount{subaccount},
+			Meta:    &paystack.Meta{Total: 2, PerPage: 1, Page: page, PageCount: 2},
+		})
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	var ids []int
+	it := client.IterateSubaccounts(context.Background(), &paystack.ListSubaccountsRequest{PerPage: 1})
+	for it.Next() {
+		ids = append(ids, it.Current().ID)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, ids, []int{55, 56})
+}
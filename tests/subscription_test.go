@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSubscription(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/subscription")
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, body["customer"], "CUS_xnxdt6s1zg1f4nx")
+		assert.Equal(t, body["plan"], "PLN_gx2wn530m0i3w3m")
+		assert.NotContains(t, body, "start_date")
+
+		// the create endpoint returns the customer and plan as bare IDs
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":true,"message":"Subscription successfully created","data":{"customer":1173,"plan":28,"integration":100032,"domain":"test","amount":50000,"status":"active","subscription_code":"SUB_vsyqdmlzble3uii","email_token":"d7gofp6yppn3qz7","authorization":{"authorization_code":"AUTH_pmx3mgawyd","last4":"4081"},"id":9}}`))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	req := &paystack.CreateSubscriptionRequest{
+		Customer: "CUS_xnxdt6s1zg1f4nx",
+		Plan:     "PLN_gx2wn530m0i3w3m",
+	}
+
+	res, err := client.CreateSubscription(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.SubscriptionCode, "SUB_vsyqdmlzble3uii")
+	assert.Equal(t, res.Data.Customer.ID, 1173)
+	assert.Equal(t, res.Data.Plan.ID, 28)
+	assert.Equal(t, res.Data.Authorization.AuthorizationCode, "AUTH_pmx3mgawyd")
+	assert.Equal(t, res.Data.Amount.Amount, int64(50000))
+	assert.True(t, res.Data.IsActive())
+
+	_, err = client.CreateSubscription(context.Background(), &paystack.CreateSubscriptionRequest{})
+	assert.Equal(t, fieldsOf(t, err), []string{"customer", "plan"})
+}
+
+func TestListAndFetchSubscriptions(t *testing.T) {
+	subscription := `{"id":9,"status":"non-renewing","subscription_code":"SUB_vsyqdmlzble3uii","email_token":"d7gofp6yppn3qz7","amount":50000,` +
+		`"customer":{"id":1173,"email":"customer@email.com","customer_code":"CUS_xnxdt6s1zg1f4nx"},` +
+		`"plan":{"id":28,"name":"Monthly retainer","plan_code":"PLN_gx2wn530m0i3w3m","amount":50000,"interval":"monthly","currency":"NGN"}}`
+
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/subscription":
+			assert.Equal(t, r.URL.Query().Get("customer"), "1173")
+			assert.False(t, r.URL.Query().Has("plan"))
+			w.Write([]byte(`{"status":true,"message":"Subscriptions retrieved","data":[` + subscription + `],"meta":{"total":1,"page":1,"pageCount":1}}`))
+		case "/subscription/SUB_vsyqdmlzble3uii":
+			w.Write([]byte(`{"status":true,"message":"Subscription retrieved","data":` + subscription + `}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	list, err := client.ListSubscriptions(context.Background(), &paystack.ListSubscriptionsRequest{Customer: 1173})
+	assert.NoError(t, err)
+	assert.Equal(t, len(list.Data), 1)
+	assert.Equal(t, list.Data[0].Customer.Email, "customer@email.com")
+
+	res, err := client.FetchSubscription(context.Background(), "SUB_vsyqdmlzble3uii")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.Status, paystack.SubscriptionStatusNonRenewing)
+	assert.False(t, res.Data.IsActive())
+	assert.Equal(t, res.Data.Plan.PlanCode, "PLN_gx2wn530m0i3w3m")
+	assert.Equal(t, res.Data.Plan.Interval, paystack.PlanIntervalMonthly)
+	assert.Equal(t, res.Data.Amount, paystack.NewMoney(50000, paystack.CurrencyNGN))
+}
+
+func TestManageSubscription(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/subscription/enable", "/subscription/disable":
+			assert.Equal(t, r.Method, "POST")
+			assert.Equal(t, body["code"], "SUB_vsyqdmlzble3uii")
+			assert.Equal(t, body["token"], "d7gofp6yppn3qz7")
+			w.Write([]byte(`{"status":true,"message":"Subscription updated"}`))
+		case "/subscription/SUB_vsyqdmlzble3uii/manage/link":
+			assert.Equal(t, r.Method, "GET")
+			w.Write([]byte(`{"status":true,"message":"Link generated","data":{"link":"https://paystack.com/manage/subscriptions/qlgwhpyq1ts9nsw?subscription_token=uqmyaqmt"}}`))
+		case "/subscription/SUB_vsyqdmlzble3uii/manage/email":
+			assert.Equal(t, r.Method, "POST")
+			w.Write([]byte(`{"status":true,"message":"Email successfully sent"}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	res, err := client.DisableSubscription(context.Background(), "SUB_vsyqdmlzble3uii", "d7gofp6yppn3qz7")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "Subscription updated")
+
+	res, err = client.EnableSubscription(context.Background(), "SUB_vsyqdmlzble3uii", "d7gofp6yppn3qz7")
+	assert.NoError(t, err)
+	assert.True(t, res.Status)
+
+	link, err := client.GenerateSubscriptionUpdateLink(context.Background(), "SUB_vsyqdmlzble3uii")
+	assert.NoError(t, err)
+	assert.Contains(t, link.Data.Link, "subscription_token=uqmyaqmt")
+
+	res, err = client.SendSubscriptionUpdateLink(context.Background(), "SUB_vsyqdmlzble3uii")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "Email successfully sent")
+}
+
+func TestValidateToggleSubscription(t *testing.T) {
+	server := newUnreachableServer(t)
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	_, err := client.EnableSubscription(context.Background(), "SUB_vsyqdmlzble3uii", "")
+	assert.Equal(t, fieldsOf(t, err), []string{"token"})
+
+	_, err = client.DisableSubscription(context.Background(), "", "")
+	assert.Equal(t, fieldsOf(t, err), []string{"code", "token"})
+}
@@ -1,12 +1,13 @@
 package tests
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
-	"github.com/aglili/gopaystack/config"
 	"github.com/aglili/gopaystack/paystack"
 
 	"github.com/stretchr/testify/assert"
@@ -17,11 +18,7 @@ func TestInitialisePayment(t *testing.T) {
 	Response := paystack.TransactionResponse{
 		Status:  true,
 		Message: "Transaction initialized",
-		Data: struct {
-			AuthorizationURL string `json:"authorization_url"`
-			AccessCode       string `json:"access_code"`
-			Reference        string `json:"reference"`
-		}{
+		Data: paystack.InitializedTransaction{
 			AuthorizationURL: "https://checkout.paystack.com/9k2f3k4",
 			AccessCode:       "9k2f3k4",
 			Reference:        "9k2f3k4",
@@ -33,24 +30,27 @@ func TestInitialisePayment(t *testing.T) {
 		assert.Equal(t, r.Method, "POST")
 		assert.Equal(t, r.URL.Path, "/transaction/initialize")
 
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, body["amount"], float64(10000))
+		assert.Equal(t, body["currency"], "NGN")
+
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(Response)
 	}))
 
 	defer server.Close()
 
-	config.BaseURL = server.URL
-
-	client := paystack.NewClient("sk_test_1234567890")
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
 
 	req := &paystack.InitializeTransactionRequest{
 		Reference:   "9k2f3k4",
-		Amount:      10000,
+		Amount:      paystack.MoneyFromMajor(100, paystack.CurrencyNGN),
 		Email:       "test@test.com",
 		CallbackURL: "https://example.com/callback",
 	}
 
-	res, err := client.InitializeTransaction(req)
+	res, err := client.InitializeTransaction(context.Background(), req)
 	assert.Nil(t, err)
 	assert.NoError(t, err)
 	assert.Equal(t, res.Status, true)
@@ -66,16 +66,32 @@ func TestVerifyTransaction(t *testing.T) {
 	Response := paystack.VerifyTransactionResponse{
 		Status:  true,
 		Message: "Transaction fetched",
-		Data: struct {
-			Amount            int    `json:"amount"`
-			TransactionDate   string `json:"transaction_date"`
-			TransactionStatus string `json:"status"`
-			Reference         string `json:"reference"`
-		}{
-			Amount:            10000,
-			TransactionDate:   "2020-12-12T12:12:12",
-			TransactionStatus: "success",
-			Reference:         "9k2f3k4",
+		Data: paystack.Transaction{
+			Amount:          paystack.NewMoney(10000, paystack.CurrencyNGN),
+			TransactionDate: "2020-12-12T12:12:12",
+			Status:          paystack.TransactionStatusSuccess,
+			Reference:       "9k2f3k4",
+			Currency:        "NGN",
+			Channel:         "card",
+			GatewayResponse: "Approved",
+			Fees:            paystack.NewMoney(150, paystack.CurrencyNGN),
+			Authorization: paystack.Authorization{
+				AuthorizationCode: "AUTH_8dfhjjdt",
+				Last4:             "4081",
+				Reusable:          true,
+			},
+			Customer: paystack.Customer{
+				ID:           1,
+				Email:        "test@test.com",
+				CustomerCode: "CUS_1234567890",
+			},
+			Log: &paystack.TransactionLog{
+				Attempts: 1,
+				Success:  true,
+				History: []paystack.TransactionHistory{
+					{Type: "success", Message: "Successfully paid", Time: 7},
+				},
+			},
 		},
 	}
 
@@ -90,19 +106,23 @@ func TestVerifyTransaction(t *testing.T) {
 
 	defer server.Close()
 
-	config.BaseURL = server.URL
-
-	client := paystack.NewClient("sk_test_1234567890")
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
 
-	res, err := client.VerifyTransaction("9k2f3k4")
+	res, err := client.VerifyTransaction(context.Background(), "9k2f3k4")
 	assert.Nil(t, err)
 	assert.NoError(t, err)
 	assert.Equal(t, res.Status, true)
 	assert.Equal(t, res.Message, "Transaction fetched")
-	assert.Equal(t, res.Data.Amount, 10000)
+	assert.Equal(t, res.Data.Amount, paystack.NewMoney(10000, paystack.CurrencyNGN))
 	assert.Equal(t, res.Data.Reference, "9k2f3k4")
-	assert.Equal(t, res.Data.TransactionStatus, "success")
+	assert.Equal(t, res.Data.Status, paystack.TransactionStatusSuccess)
 	assert.Equal(t, res.Data.TransactionDate, "2020-12-12T12:12:12")
+	assert.Equal(t, res.Data.GatewayResponse, "Approved")
+	assert.Equal(t, res.Data.Fees, paystack.NewMoney(150, paystack.CurrencyNGN))
+	assert.Equal(t, res.Data.Authorization.AuthorizationCode, "AUTH_8dfhjjdt")
+	assert.True(t, res.Data.Authorization.Reusable)
+	assert.Equal(t, res.Data.Customer.CustomerCode, "CUS_1234567890")
+	assert.Equal(t, res.Data.Log.History[0].Message, "Successfully paid")
 }
 
 func TestListTransactions(t *testing.T) {
@@ -110,23 +130,15 @@ func TestListTransactions(t *testing.T) {
 	Response := paystack.ListTransactionsResponse{
 		Status:  true,
 		Message: "Transactions fetched",
-		Data: []struct {
-			ID              int    `json:"id"`
-			TransactionDate string `json:"transaction_date"`
-			Amount          int    `json:"amount"`
-			Currency        string `json:"currency"`
-			Channel         string `json:"channel"`
-			Reference       string `json:"reference"`
-			Status          string `json:"status"`
-		}{
+		Data: []paystack.Transaction{
 			{
 				ID:              1,
 				TransactionDate: "2020-12-12T12:12:12",
-				Amount:          10000,
+				Amount:          paystack.NewMoney(10000, paystack.CurrencyNGN),
 				Currency:        "NGN",
 				Channel:         "card",
 				Reference:       "9k2f3k4",
-				Status:          "success",
+				Status:          paystack.TransactionStatusSuccess,
 			},
 		},
 	}
@@ -135,6 +147,12 @@ func TestListTransactions(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, r.Method, "GET")
 		assert.Equal(t, r.URL.Path, "/transaction")
+		assert.Equal(t, r.URL.Query().Get("perPage"), "10")
+		assert.Equal(t, r.URL.Query().Get("page"), "1")
+		assert.Equal(t, r.URL.Query().Get("status"), "success")
+		assert.Equal(t, r.URL.Query().Get("customer"), "42")
+		assert.Equal(t, r.URL.Query().Get("from"), "2020-12-01T00:00:00Z")
+		assert.False(t, r.URL.Query().Has("to"))
 
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(Response)
@@ -142,16 +160,17 @@ func TestListTransactions(t *testing.T) {
 
 	defer server.Close()
 
-	config.BaseURL = server.URL
-
-	client := paystack.NewClient("sk_test_1234567890")
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
 
 	req := &paystack.ListTransactionsRequest{
-		PerPage: 10,
-		Page:    1,
+		PerPage:  10,
+		Page:     1,
+		Status:   paystack.TransactionStatusSuccess,
+		Customer: 42,
+		From:     time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC),
 	}
 
-	res, err := client.ListTransactions(req)
+	res, err := client.ListTransactions(context.Background(), req)
 	assert.Nil(t, err)
 	assert.NoError(t, err)
 	assert.Equal(t, res.Status, true)
@@ -159,8 +178,8 @@ func TestListTransactions(t *testing.T) {
 	assert.Equal(t, len(res.Data), 1)
 	assert.Equal(t, res.Data[0].ID, 1)
 	assert.Equal(t, res.Data[0].TransactionDate, "2020-12-12T12:12:12")
-	assert.Equal(t, res.Data[0].Amount, 10000)
-	assert.Equal(t, res.Data[0].Currency, "NGN")
+	assert.Equal(t, res.Data[0].Amount, paystack.NewMoney(10000, paystack.CurrencyNGN))
+	assert.Equal(t, res.Data[0].Currency, paystack.CurrencyNGN)
 	assert.Equal(t, res.Data[0].Channel, "card")
 }
 
@@ -170,16 +189,11 @@ func TestFetchTransaction(t *testing.T) {
 	Response := paystack.VerifyTransactionResponse{
 		Status:  true,
 		Message: "Transaction fetched",
-		Data: struct {
-			Amount            int    `json:"amount"`
-			TransactionDate   string `json:"transaction_date"`
-			TransactionStatus string `json:"status"`
-			Reference         string `json:"reference"`
-		}{
-			Amount:            10000,
-			TransactionDate:   "2020-12-12T12:12:12",
-			TransactionStatus: "success",
-			Reference:         "9k2f3k4",
+		Data: paystack.Transaction{
+			Amount:          paystack.NewMoney(10000, paystack.CurrencyNGN),
+			TransactionDate: "2020-12-12T12:12:12",
+			Status:          paystack.TransactionStatusSuccess,
+			Reference:       "9k2f3k4",
 		},
 	}
 
@@ -194,19 +208,264 @@ func TestFetchTransaction(t *testing.T) {
 
 	defer server.Close()
 
-	config.BaseURL = server.URL
-
-	client := paystack.NewClient("sk_test_1234567890")
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
 
-	res, err := client.FetchTransaction("9k2f3k4")
+	res, err := client.FetchTransaction(context.Background(), "9k2f3k4")
 	assert.Nil(t, err)
 	assert.NoError(t, err)
 	assert.Equal(t, res.Status, true)
 	assert.Equal(t, res.Message, "Transaction fetched")
-	assert.Equal(t, res.Data.Amount, 10000)
+	assert.Equal(t, res.Data.Amount.Amount, int64(10000))
 	assert.Equal(t, res.Data.Reference, "9k2f3k4")
-	assert.Equal(t, res.Data.TransactionStatus, "success")
+	assert.Equal(t, res.Data.Status, paystack.TransactionStatusSuccess)
 	assert.Equal(t, res.Data.TransactionDate, "2020-12-12T12:12:12")
 	assert.NotEqual(t, res.Data.TransactionDate, "2020-12-12T12:12:13")
 
 }
+
+func TestChargeAuthorization(t *testing.T) {
+	// mock the response
+	Response := paystack.ChargeAuthorizationResponse{
+		Status:  true,
+		Message: "Charge attempted",
+		Data: paystack.Transaction{
+			ID:        1,
+			Amount:    paystack.NewMoney(10000, paystack.CurrencyNGN),
+			Currency:  "NGN",
+			Reference: "recurring-001",
+			Status:    paystack.TransactionStatusSuccess,
+			Authorization: paystack.Authorization{
+				AuthorizationCode: "AUTH_8dfhjjdt",
+				Reusable:          true,
+			},
+		},
+	}
+
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/transaction/charge_authorization")
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, body["authorization_code"], "AUTH_8dfhjjdt")
+		assert.Equal(t, body["email"], "test@test.com")
+		assert.Equal(t, body["amount"], float64(10000))
+		assert.Equal(t, body["currency"], "NGN")
+		assert.Equal(t, body["queue"], true)
+		assert.NotContains(t, body, "split_code")
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Response)
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	req := &paystack.ChargeAuthorizationRequest{
+		AuthorizationCode: "AUTH_8dfhjjdt",
+		Email:             "test@test.com",
+		Amount:            paystack.NewMoney(10000, paystack.CurrencyNGN),
+		Reference:         "recurring-001",
+		Queue:             true,
+	}
+
+	res, err := client.ChargeAuthorization(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "Charge attempted")
+	assert.Equal(t, res.Data.Reference, "recurring-001")
+	assert.True(t, res.Data.IsSuccessful())
+	assert.False(t, res.Data.RequiresOTP())
+	assert.False(t, res.Data.IsPending())
+}
+
+func TestChargeAuthorizationSendOTP(t *testing.T) {
+	// create a mock server asking for an OTP
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":true,"message":"Charge attempted","data":{"reference":"recurring-002","status":"send_otp","display_text":"Please enter OTP"}}`))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	res, err := client.ChargeAuthorization(context.Background(), &paystack.ChargeAuthorizationRequest{
+		AuthorizationCode: "AUTH_8dfhjjdt",
+		Email:             "test@test.com",
+		Amount:            paystack.NewMoney(10000, paystack.CurrencyNGN),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.Status, paystack.TransactionStatusSendOTP)
+	assert.True(t, res.Data.RequiresOTP())
+	assert.False(t, res.Data.IsSuccessful())
+	assert.Equal(t, res.Data.DisplayText, "Please enter OTP")
+}
+
+func TestPartialDebit(t *testing.T) {
+	// mock the response
+	Response := paystack.PartialDebitResponse{
+		Status:  true,
+		Message: "Charge attempted",
+		Data: paystack.Transaction{
+			Amount:          paystack.NewMoney(6000, paystack.CurrencyNGN),
+			RequestedAmount: paystack.NewMoney(10000, paystack.CurrencyNGN),
+			Currency:        "NGN",
+			Reference:       "loan-001",
+			Status:          paystack.TransactionStatusSuccess,
+		},
+	}
+
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/transaction/partial_debit")
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, body["authorization_code"], "AUTH_8dfhjjdt")
+		assert.Equal(t, body["currency"], "NGN")
+		assert.Equal(t, body["amount"], float64(10000))
+		assert.Equal(t, body["at_least"], "5000")
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Response)
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	req := &paystack.PartialDebitRequest{
+		AuthorizationCode: "AUTH_8dfhjjdt",
+		Amount:            paystack.NewMoney(10000, paystack.CurrencyNGN),
+		Email:             "test@test.com",
+		AtLeast:           paystack.NewMoney(5000, paystack.CurrencyNGN),
+	}
+
+	res, err := client.PartialDebit(context.Background(), req)
+	assert.NoError(t, err)
+	assert.True(t, res.Data.IsSuccessful())
+	assert.Equal(t, res.Data.Amount.Amount, int64(6000))
+	assert.Equal(t, res.Data.RequestedAmount.Amount, int64(10000))
+}
+
+func TestViewTransactionTimeline(t *testing.T) {
+	// mock the response
+	Response := paystack.TransactionTimelineResponse{
+		Status:  true,
+		Message: "Timeline retrieved",
+		Data: paystack.TransactionLog{
+			TimeSpent: 9,
+			Attempts:  1,
+			Success:   true,
+			History: []paystack.TransactionHistory{
+				{Type: "action", Message: "Attempted to pay", Time: 1},
+				{Type: "success", Message: "Successfully paid", Time: 9},
+			},
+		},
+	}
+
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/transaction/timeline/9k2f3k4")
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Response)
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	res, err := client.ViewTransactionTimeline(context.Background(), "9k2f3k4")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.TimeSpent, 9)
+	assert.Equal(t, len(res.Data.History), 2)
+	assert.Equal(t, res.Data.History[1].Message, "Successfully paid")
+}
+
+func TestTransactionTotals(t *testing.T) {
+	// mock the response
+	Response := paystack.TransactionTotalsResponse{
+		Status:  true,
+		Message: "Transaction totals",
+		Data: paystack.TransactionTotals{
+			TotalTransactions: 42,
+			TotalVolume:       paystack.Money{Amount: 6617857946},
+			TotalVolumeByCurrency: []paystack.CurrencyAmount{
+				{Currency: "NGN", Amount: paystack.NewMoney(6617829946, paystack.CurrencyNGN)},
+				{Currency: "USD", Amount: paystack.NewMoney(28000, paystack.CurrencyUSD)},
+			},
+			PendingTransfers: paystack.Money{Amount: 6617829946},
+			PendingTransfersByCurrency: []paystack.CurrencyAmount{
+				{Currency: "NGN", Amount: paystack.NewMoney(6617829946, paystack.CurrencyNGN)},
+			},
+		},
+	}
+
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/transaction/totals")
+		assert.Equal(t, r.URL.Query().Get("from"), "2024-01-01T00:00:00Z")
+		assert.Equal(t, r.URL.Query().Get("to"), "2024-02-01T00:00:00Z")
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Response)
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	res, err := client.TransactionTotals(context.Background(), &paystack.TransactionTotalsRequest{
+		From: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.TotalTransactions, 42)
+	assert.Equal(t, len(res.Data.TotalVolumeByCurrency), 2)
+	assert.Equal(t, res.Data.TotalVolumeByCurrency[1].Currency, paystack.CurrencyUSD)
+	assert.Equal(t, res.Data.TotalVolumeByCurrency[1].Amount, paystack.NewMoney(28000, paystack.CurrencyUSD))
+	assert.Equal(t, res.Data.TotalVolume, paystack.Money{Amount: 6617857946})
+	assert.Equal(t, res.Data.PendingTransfers, paystack.NewMoney(6617829946, paystack.CurrencyNGN))
+}
+
+func TestExportTransactions(t *testing.T) {
+	// mock the response
+	Response := paystack.ExportTransactionsResponse{
+		Status:  true,
+		Message: "Export successful",
+		Data: paystack.TransactionExport{
+			Path:      "https://files.paystack.co/exports/100032/1460290758207.csv",
+			ExpiresAt: "2024-01-01 00:10:00",
+		},
+	}
+
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/transaction/export")
+		assert.Equal(t, r.URL.Query().Get("settled"), "false")
+		assert.Equal(t, r.URL.Query().Get("currency"), "NGN")
+		assert.False(t, r.URL.Query().Has("status"))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Response)
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	settled := false
+	res, err := client.ExportTransactions(context.Background(), &paystack.ExportTransactionsRequest{
+		Currency: "NGN",
+		Settled:  &settled,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.Path, "https://files.paystack.co/exports/100032/1460290758207.csv")
+}
@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckBalance(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/balance")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":true,"message":"Balances retrieved","data":[{"currency":"NGN","balance":1700000},{"currency":"USD","balance":2500}]}`))
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	res, err := client.CheckBalance(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, len(res.Data), 2)
+	assert.Equal(t, res.Data[0].Balance, paystack.NewMoney(1700000, paystack.CurrencyNGN))
+	assert.Equal(t, res.Data[1].Balance.String(), "USD 25.00")
+}
+
+func TestIterateBalanceLedger(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		assert.Equal(t, r.URL.Path, "/balance/ledger")
+		assert.Equal(t, r.URL.Query().Get("perPage"), "1")
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Write([]byte(`{"status":true,"message":"Balance ledger retrieved","data":[{"id":1,"currency":"NGN","balance":1700000,"difference":-20000,"reason":"Transfer","model_responsible":"Transfer","model_row":14938}],"meta":{"total":2,"perPage":1,"page":1,"pageCount":2}}`))
+		case "2":
+			w.Write([]byte(`{"status":true,"message":"Balance ledger retrieved","data":[{"id":2,"currency":"NGN","balance":1720000,"difference":50000,"reason":"Settlement","model_responsible":"Settlement","model_row":36}],"meta":{"total":2,"perPage":1,"page":2,"pageCount":2}}`))
+		default:
+			t.Errorf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	var entries []paystack.BalanceLedgerEntry
+	it := client.IterateBalanceLedger(context.Background(), &paystack.BalanceLedgerRequest{PerPage: 1})
+	for it.Next() {
+		entries = append(entries, it.Current())
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, len(entries), 2)
+	assert.Equal(t, entries[0].Difference, paystack.NewMoney(-20000, paystack.CurrencyNGN))
+	assert.Equal(t, entries[1].ModelResponsible, "Settlement")
+}
+
+func TestTransferOTPControls(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/transfer/resend_otp":
+			assert.Equal(t, body["transfer_code"], "TRF_vsyqdmlzble3uii")
+			assert.Equal(t, body["reason"], "resend_otp")
+			w.Write([]byte(`{"status":true,"message":"OTP has been resent"}`))
+		case "/transfer/disable_otp":
+			w.Write([]byte(`{"status":true,"message":"OTP has been sent to mobile number ending with 4321"}`))
+		case "/transfer/disable_otp_finalize":
+			assert.Equal(t, body["otp"], "928783")
+			w.Write([]byte(`{"status":true,"message":"OTP requirement for transfers has been disabled"}`))
+		case "/transfer/enable_otp":
+			w.Write([]byte(`{"status":true,"message":"OTP requirement for transfers has been enabled"}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	res, err := client.ResendTransferOTP(context.Background(), &paystack.ResendTransferOTPRequest{
+		TransferCode: "TRF_vsyqdmlzble3uii",
+		Reason:       paystack.ResendOTPReasonResendOTP,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "OTP has been resent")
+
+	res, err = client.DisableTransferOTP(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "OTP has been sent to mobile number ending with 4321")
+
+	res, err = client.FinalizeDisableOTP(context.Background(), "928783")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "OTP requirement for transfers has been disabled")
+
+	res, err = client.EnableTransferOTP(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, res.Message, "OTP requirement for transfers has been enabled")
+
+	_, err = client.ResendTransferOTP(context.Background(), &paystack.ResendTransferOTPRequest{TransferCode: "TRF_vsyqdmlzble3uii"})
+	assert.Equal(t, fieldsOf(t, err), []string{"reason"})
+
+	_, err = client.FinalizeDisableOTP(context.Background(), " ")
+	assert.Equal(t, fieldsOf(t, err), []string{"otp"})
+}
@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitiateAndFinalizeTransfer(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/transfer":
+			assert.Equal(t, body["source"], "balance")
+			assert.Equal(t, body["amount"], float64(370000))
+			assert.Equal(t, body["currency"], "NGN")
+			assert.Equal(t, body["recipient"], "RCP_t0ya41mp35flk40")
+			assert.Equal(t, body["reference"], "payout_2024_001")
+			// the initiate endpoint returns the recipient as a bare ID
+			w.Write([]byte(`{"status":true,"message":"Transfer requires OTP to continue","data":{"integration":100073,"domain":"test","amount":370000,"currency":"NGN","source":"balance","reason":"Calm down","recipient":28,"status":"otp","transfer_code":"TRF_1ptvuv321ahaa7q","id":37272792}}`))
+		case "/transfer/finalize_transfer":
+			assert.Equal(t, body["transfer_code"], "TRF_1ptvuv321ahaa7q")
+			assert.Equal(t, body["otp"], "928783")
+			w.Write([]byte(`{"status":true,"message":"Transfer has been queued","data":{"amount":370000,"currency":"NGN","recipient":28,"status":"success","transfer_code":"TRF_1ptvuv321ahaa7q","id":37272792}}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	req := &paystack.InitiateTransferRequest{
+		Amount:    paystack.NewMoney(370000, paystack.CurrencyNGN),
+		Recipient: "RCP_t0ya41mp35flk40",
+		Reference: "payout_2024_001",
+		Reason:    "Calm down",
+	}
+
+	res, err := client.InitiateTransfer(context.Background(), req)
+	assert.NoError(t, err)
+	assert.True(t, res.Data.RequiresOTP())
+	assert.Equal(t, res.Data.Recipient.ID, 28)
+	assert.Equal(t, res.Data.Amount, paystack.NewMoney(370000, paystack.CurrencyNGN))
+
+	res, err = client.FinalizeTransfer(context.Background(), &paystack.FinalizeTransferRequest{
+		TransferCode: res.Data.TransferCode,
+		OTP:          "928783",
+	})
+	assert.NoError(t, err)
+	assert.True(t, res.Data.IsSuccessful())
+
+	_, err = client.FinalizeTransfer(context.Background(), &paystack.FinalizeTransferRequest{TransferCode: "TRF_1ptvuv321ahaa7q"})
+	assert.Equal(t, fieldsOf(t, err), []string{"otp"})
+
+	// transfer references only allow lowercase letters, digits, '-' and '_'
+	req.Reference = "Payout.2024=001"
+	_, err = client.InitiateTransfer(context.Background(), req)
+	assert.Equal(t, fieldsOf(t, err), []string{"reference"})
+}
+
+func TestInitiateBulkTransfer(t *testing.T) {
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		assert.Equal(t, r.URL.Path, "/transfer/bulk")
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, body["source"], "balance")
+		assert.Equal(t, body["currency"], "NGN")
+		assert.Equal(t, len(body["transfers"].([]interface{})), 2)
+		assert.Equal(t, body["transfers"].([]interface{})[0].(map[string]interface{})["amount"], float64(20000))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(paystack.BulkTransferResponse{
+			Status:  true,
+			Message: "2 transfers queued.",
+			Data: []paystack.BulkTransfer{
+				{Reference: "acv-9ee55786", Recipient: "RCP_gd9vgag7n5lr5ix", Amount: paystack.NewMoney(20000, paystack.CurrencyNGN), TransferCode: "TRF_ful7aa6i8l8dm0s", Currency: paystack.CurrencyNGN, Status: paystack.TransferStatusReceived},
+				{Reference: "acv-1bbe8a2f", Recipient: "RCP_zpk2tgagu6lgb4g", Amount: paystack.NewMoney(35000, paystack.CurrencyNGN), TransferCode: "TRF_1ptvuv321ahaa7q", Currency: paystack.CurrencyNGN, Status: paystack.TransferStatusReceived},
+			},
+		})
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	req := &paystack.InitiateBulkTransferRequest{
+		Transfers: []paystack.BulkTransferItem{
+			{Amount: paystack.NewMoney(20000, paystack.CurrencyNGN), Recipient: "RCP_gd9vgag7n5lr5ix", Reference: "acv-9ee55786"},
+			{Amount: paystack.NewMoney(35000, paystack.CurrencyNGN), Recipient: "RCP_zpk2tgagu6lgb4g", Reference: "acv-1bbe8a2f"},
+		},
+	}
+
+	res, err := client.InitiateBulkTransfer(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, len(res.Data), 2)
+	assert.Equal(t, res.Data[1].Status, paystack.TransferStatusReceived)
+	assert.Equal(t, res.Data[1].Amount, paystack.NewMoney(35000, paystack.CurrencyNGN))
+
+	req.Transfers[0].Amount.Amount = 0
+	req.Transfers[1].Amount.Currency = paystack.CurrencyGHS
+	req.Transfers[1].Reference = "ACV-1BBE8A2F"
+	_, err = client.InitiateBulkTransfer(context.Background(), req)
+	assert.Equal(t, fieldsOf(t, err), []string{"transfers[0].amount", "transfers[1].reference", "transfers[1].amount"})
+}
+
+func TestListFetchAndVerifyTransfers(t *testing.T) {
+	transfer := `{"id":14938,"amount":20000,"currency":"NGN","reference":"acv-9ee55786","status":"success","transfer_code":"TRF_ful7aa6i8l8dm0s",` +
+		`"recipient":{"id":6788170,"type":"nuban","name":"Tolu Robert","recipient_code":"RCP_t0ya41mp35flk40","details":{"account_number":"01000000010","bank_code":"058"}}}`
+
+	// create a mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/transfer":
+			assert.Equal(t, r.URL.Query().Get("status"), "success")
+			w.Write([]byte(`{"status":true,"message":"Transfers retrieved","data":[` + transfer + `],"meta":{"total":1,"page":1,"pageCount":1}}`))
+		case "/transfer/TRF_ful7aa6i8l8dm0s", "/transfer/verify/acv-9ee55786":
+			w.Write([]byte(`{"status":true,"message":"Transfer retrieved","data":` + transfer + `}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	list, err := client.ListTransfers(context.Background(), &paystack.ListTransfersRequest{Status: paystack.TransferStatusSuccess})
+	assert.NoError(t, err)
+	assert.Equal(t, len(list.Data), 1)
+	assert.Equal(t, list.Data[0].Recipient.RecipientCode, "RCP_t0ya41mp35flk40")
+
+	res, err := client.FetchTransfer(context.Background(), "TRF_ful7aa6i8l8dm0s")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.ID, 14938)
+	assert.Equal(t, res.Data.Recipient.Details.BankCode, "058")
+
+	res, err = client.VerifyTransfer(context.Background(), "acv-9ee55786")
+	assert.NoError(t, err)
+	assert.Equal(t, res.Data.Status, paystack.TransferStatusSuccess)
+}
@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/stretchr/testify/assert"
+)
+
+// newUnreachableServer returns a server that fails the test if a request
+// that should have been rejected client-side reaches it.
+func newUnreachableServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+	}))
+}
+
+// fieldsOf returns the names of the fields listed in a *ValidationError.
+func fieldsOf(t *testing.T, err error) []string {
+	var validationErr *paystack.ValidationError
+	if !assert.True(t, errors.As(err, &validationErr)) {
+		return nil
+	}
+
+	var fields []string
+	for _, fieldErr := range validationErr.Errors {
+		fields = append(fields, fieldErr.Field)
+	}
+	return fields
+}
+
+func TestValidateInitializeTransaction(t *testing.T) {
+	server := newUnreachableServer(t)
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	res, err := client.InitializeTransaction(context.Background(), &paystack.InitializeTransactionRequest{
+		Reference: "order#1",
+		Email:     "not-an-email",
+		Amount:    paystack.NewMoney(0, "EUR"),
+	})
+	assert.Nil(t, res)
+	assert.Equal(t, fieldsOf(t, err), []string{"email", "amount", "amount", "reference"})
+	assert.Contains(t, err.Error(), "email: must be a valid email address")
+	assert.True(t, paystack.IsValidationError(err))
+	assert.False(t, paystack.IsNotFound(err))
+}
+
+func TestValidateCreateCustomerAndPlan(t *testing.T) {
+	server := newUnreachableServer(t)
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	_, err := client.CreateCustomer(context.Background(), &paystack.CreateCustomerRequest{FirstName: "John"})
+	assert.Equal(t, fieldsOf(t, err), []string{"email"})
+
+	_, err = client.CreatePlan(context.Background(), &paystack.CreatePlanRequest{
+		Amount:   paystack.NewMoney(5000, paystack.CurrencyNGN),
+		Interval: "fortnightly",
+		Currency: paystack.CurrencyGHS,
+	})
+	assert.Equal(t, fieldsOf(t, err), []string{"name", "interval", "currency"})
+}
+
+func TestValidateListRequests(t *testing.T) {
+	server := newUnreachableServer(t)
+	defer server.Close()
+
+	client := paystack.NewClient("sk_test_1234567890", paystack.WithBaseURL(server.URL))
+
+	_, err := client.ListTransactions(context.Background(), &paystack.ListTransactionsRequest{
+		PerPage:  -1,
+		Currency: "EUR",
+		From:     time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	assert.Equal(t, fieldsOf(t, err), []string{"perPage", "to", "currency"})
+
+	_, err = client.PartialDebit(context.Background(), &paystack.PartialDebitRequest{
+		AuthorizationCode: "AUTH_8dfhjjdt",
+		Email:             "test@test.com",
+		Amount:            paystack.Money{Amount: 1000},
+		AtLeast:           paystack.Money{Amount: 2000},
+	})
+	assert.Equal(t, fieldsOf(t, err), []string{"currency", "at_least"})
+}
@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aglili/gopaystack/paystack"
+	"github.com/aglili/gopaystack/paystack/webhook"
+	"github.com/stretchr/testify/assert"
+)
+
+const webhookSecret = "sk_test_1234567890"
+
+// newWebhookRequest builds a webhook delivery signed with the given key.
+func newWebhookRequest(key, body string) *http.Request {
+	mac := hmac.New(sha512.New, []byte(key))
+	mac.Write([]byte(body))
+
+	req := httptest.NewRequest("POST", "/webhook", bytes.NewBufferString(body))
+	req.Header.Set(webhook.SignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+func TestWebhookHandlerDispatch(t *testing.T) {
+	body := `{"event":"charge.success","data":{"id":302961,"reference":"qTPrJoy9Bx","amount":10000,"status":"success"}}`
+
+	handler := webhook.NewHandler(webhookSecret)
+
+	var reference string
+	handler.On(webhook.EventChargeSuccess, func(ctx context.Context, event *webhook.Event) error {
+		var data struct {
+			Reference string `json:"reference"`
+		}
+		if err := event.DecodeData(&data); err != nil {
+			return err
+		}
+		reference = data.Reference
+		return nil
+	})
+	handler.On(webhook.EventTransferSuccess, func(ctx context.Context, event *webhook.Event) error {
+		t.Fatal("transfer.success callback should not run")
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest(webhookSecret, body))
+
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.Equal(t, reference, "qTPrJoy9Bx")
+}
+
+func TestWebhookHandlerRejectsBadSignature(t *testing.T) {
+	body := `{"event":"charge.success","data":{}}`
+
+	handler := webhook.NewHandler(webhookSecret)
+	handler.On(webhook.EventChargeSuccess, func(ctx context.Context, event *webhook.Event) error {
+		t.Fatal("callback should not run for an unverified event")
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest("sk_test_wrong", body))
+	assert.Equal(t, rec.Code, http.StatusUnauthorized)
+
+	req := httptest.NewRequest("POST", "/webhook", bytes.NewBufferString(body))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, rec.Code, http.StatusUnauthorized)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/webhook", nil))
+	assert.Equal(t, rec.Code, http.StatusMethodNotAllowed)
+}
+
+func TestWebhookHandlerErrors(t *testing.T) {
+	handler := webhook.NewHandler(webhookSecret)
+	handler.On(webhook.EventRefundProcessed, func(ctx context.Context, event *webhook.Event) error {
+		return errors.New("database unavailable")
+	})
+
+	var unhandled webhook.EventType
+	handler.OnUnhandled(func(ctx context.Context, event *webhook.Event) error {
+		unhandled = event.Type
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest(webhookSecret, `{"event":"refund.processed","data":{}}`))
+	assert.Equal(t, rec.Code, http.StatusInternalServerError)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest(webhookSecret, `{"event":"subscription.create","data":{}}`))
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.Equal(t, unhandled, webhook.EventSubscriptionCreate)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest(webhookSecret, `not json`))
+	assert.Equal(t, rec.Code, http.StatusBadRequest)
+}
+
+func TestWebhookDecode(t *testing.T) {
+	typed, err := webhook.Decode([]byte(`{"event":"charge.success","data":{"id":302961,"reference":"qTPrJoy9Bx","amount":10000,"currency":"NGN","status":"success"}}`))
+	assert.NoError(t, err)
+	charge, ok := typed.(*webhook.ChargeEvent)
+	assert.True(t, ok)
+	assert.Equal(t, charge.EventType(), webhook.EventChargeSuccess)
+	assert.Equal(t, charge.Data.ID, 302961)
+	assert.Equal(t, charge.Data.Reference, "qTPrJoy9Bx")
+	assert.Equal(t, charge.Data.Amount, paystack.NewMoney(10000, paystack.CurrencyNGN))
+
+	typed, err = webhook.Decode([]byte(`{"event":"subscription.create","data":{"subscription_code":"SUB_vsyqdmlzble3uii","status":"active","plan":{"name":"Monthly retainer","plan_code":"PLN_gx2wn530m0i3w3m"},"customer":{"email":"test@test.com","customer_code":"CUS_xnxdt6s1zg1f4nx"}}}`))
+	assert.NoError(t, err)
+	subscription, ok := typed.(*webhook.SubscriptionEvent)
+	assert.True(t, ok)
+	assert.Equal(t, subscription.Data.SubscriptionCode, "SUB_vsyqdmlzble3uii")
+	assert.Equal(t, subscription.Data.Plan.PlanCode, "PLN_gx2wn530m0i3w3m")
+	assert.Equal(t, subscription.Data.Customer.CustomerCode, "CUS_xnxdt6s1zg1f4nx")
+
+	typed, err = webhook.Decode([]byte(`{"event":"charge.dispute.create","data":{"id":358950,"status":"awaiting-merchant-feedback","transaction":{"reference":"qTPrJoy9Bx"}}}`))
+	assert.NoError(t, err)
+	dispute, ok := typed.(*webhook.DisputeEvent)
+	assert.True(t, ok)
+	assert.Equal(t, dispute.Data.Transaction.Reference, "qTPrJoy9Bx")
+
+	typed, err = webhook.Decode([]byte(`{"event":"transfer.success","data":{"amount":370000,"currency":"GHS","transfer_code":"TRF_1ptvuv321ahaa7q"}}`))
+	assert.NoError(t, err)
+	transfer, ok := typed.(*webhook.TransferEvent)
+	assert.True(t, ok)
+	assert.Equal(t, transfer.Data.Amount, paystack.NewMoney(370000, paystack.CurrencyGHS))
+
+	typed, err = webhook.Decode([]byte(`{"event":"invoice.create","data":{"invoice_code":"INV_thy2vr11h7rs4rf","amount":50000,"subscription":{"amount":50000,"plan":{"currency":"NGN"}},"transaction":{}}}`))
+	assert.NoError(t, err)
+	invoice, ok := typed.(*webhook.InvoiceEvent)
+	assert.True(t, ok)
+	assert.Equal(t, invoice.Data.Amount, paystack.NewMoney(50000, paystack.CurrencyNGN))
+	assert.Equal(t, invoice.Data.Subscription.Amount, paystack.NewMoney(50000, paystack.CurrencyNGN))
+
+	typed, err = webhook.Decode([]byte(`{"event":"refund.processed","data":{"status":"processed","transaction_reference":"qTPrJoy9Bx","amount":"5000","currency":"NGN"}}`))
+	assert.NoError(t, err)
+	refund, ok := typed.(*webhook.RefundEvent)
+	assert.True(t, ok)
+	assert.Equal(t, refund.Data.Amount, paystack.NewMoney(5000, paystack.CurrencyNGN))
+
+	typed, err = webhook.Decode([]byte(`{"event":"storefront.created","data":{"id":1}}`))
+	assert.NoError(t, err)
+	raw, ok := typed.(*webhook.RawEvent)
+	assert.True(t, ok)
+	assert.Equal(t, raw.EventType(), webhook.EventType("storefront.created"))
+	assert.JSONEq(t, string(raw.Data), `{"id":1}`)
+
+	_, err = webhook.Decode([]byte(`{"event":"transfer.success","data":{"amount":"not a number"}}`))
+	assert.Error(t, err)
+}